@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var annotateCmd = &cobra.Command{
+	Use:   "annotate",
+	Short: "Write the last review's findings into the code as TODO comments",
+	Long: `Insert a comment above each line the last review flagged, without
+applying any fixes:
+
+    // PREREVIEW(warning): Unchecked error return
+
+The comments use each file's own comment syntax, so findings can be
+addressed later in the editor. Strip them again with:
+  prereview annotate --remove`,
+	Run: runAnnotate,
+}
+
+func init() {
+	rootCmd.AddCommand(annotateCmd)
+	annotateCmd.Flags().Bool("remove", false, "Strip previously inserted annotation comments")
+}
+
+func runAnnotate(cmd *cobra.Command, args []string) {
+	dir, err := git.PrereviewDir()
+	if err != nil {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	result, err := review.LoadResult(dir)
+	if err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+
+	if remove, _ := cmd.Flags().GetBool("remove"); remove {
+		res, err := review.RemoveAnnotations(result.Files)
+		if err != nil {
+			ui.Error(fmt.Sprintf("Failed to remove annotations: %v", err))
+			os.Exit(1)
+		}
+		if res.Removed == 0 {
+			ui.Info("No annotation comments found.")
+			return
+		}
+		ui.Success(fmt.Sprintf("✓ Removed %d annotation(s) from %d file(s)", res.Removed, res.FilesChanged))
+		return
+	}
+
+	if len(result.Suggestions) == 0 {
+		ui.Info("The last review found no suggestions - nothing to annotate.")
+		return
+	}
+
+	res, err := review.AnnotateFiles(result.Suggestions)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to annotate: %v", err))
+		os.Exit(1)
+	}
+	if res.Inserted == 0 {
+		ui.Info("All findings are already annotated.")
+		return
+	}
+	ui.Success(fmt.Sprintf("✓ Inserted %d annotation(s) into %d file(s)", res.Inserted, res.FilesChanged))
+	ui.Muted("  Run 'prereview annotate --remove' to strip them again.")
+}
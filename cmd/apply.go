@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var applySuggestionID string
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply a specific fix from the last review",
+	Long: `Apply a single suggested fix from the most recent review, identified by
+its suggestion ID. IDs are shown in verbose output and report files, so CI
+bots and editor plugins can apply a specific fix without re-running the
+whole interactive session.`,
+	Run: runApply,
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().StringVar(&applySuggestionID, "suggestion", "", "ID of the suggestion to apply")
+	_ = applyCmd.MarkFlagRequired("suggestion")
+}
+
+func runApply(cmd *cobra.Command, args []string) {
+	if !git.IsGitRepo() {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	dir, err := git.PrereviewDir()
+	if err != nil {
+		ui.Error(fmt.Sprintf("Could not locate prereview data directory: %v", err))
+		os.Exit(1)
+	}
+
+	result, err := review.LoadResult(dir)
+	if err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+
+	for _, sug := range result.Suggestions {
+		if review.SuggestionID(sug) != applySuggestionID {
+			continue
+		}
+
+		session := ui.NewReviewSession(result, viper.GetBool("verbose"))
+		if !session.Apply(sug) {
+			ui.Error(fmt.Sprintf("Could not apply fix %s to %s - the file may have changed since the review", applySuggestionID, sug.File))
+			os.Exit(1)
+		}
+		ui.Success(fmt.Sprintf("✓ Applied fix %s to %s", applySuggestionID, sug.File))
+		return
+	}
+
+	ui.Error(fmt.Sprintf("No suggestion with ID %s in the last review", applySuggestionID))
+	os.Exit(1)
+}
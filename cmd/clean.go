@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var cleanCmd = &cobra.Command{
+	Use:   "clean",
+	Short: "Prune caches, history, and stale report files",
+	Long: `Prune prereview's working data according to the retention settings:
+  cache_ttl               how long cached responses are kept (default 168h)
+  history_retention_days  how long history, transcripts, and report files are kept (default 90)
+
+Removes expired cache entries, old history entries and transcripts, stale
+suggestions_*.md report files, and leftover lock files, keeping the
+.git/prereview directory from growing unbounded.`,
+	Run: runClean,
+}
+
+func init() {
+	rootCmd.AddCommand(cleanCmd)
+}
+
+func runClean(cmd *cobra.Command, args []string) {
+	if !git.IsGitRepo() {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	dir, err := git.PrereviewDir()
+	if err != nil {
+		ui.Error(fmt.Sprintf("Could not locate prereview data directory: %v", err))
+		os.Exit(1)
+	}
+
+	cacheTTL := viper.GetDuration("cache_ttl")
+	retentionDays := viper.GetInt("history_retention_days")
+	retention := time.Duration(retentionDays) * 24 * time.Hour
+
+	removed := 0
+
+	// Expired cache entries
+	removed += pruneOlderThan(filepath.Join(dir, "cache"), cacheTTL)
+
+	// Old history entries and transcripts
+	removed += pruneOlderThan(filepath.Join(dir, "history"), retention)
+	removed += pruneOlderThan(filepath.Join(dir, "transcripts"), retention)
+
+	// Leftover lock files are always stale once no prereview is running
+	removed += pruneMatching(dir, "*.lock")
+
+	// Stale report files at the repo root and under .github
+	if repoRoot, err := git.GetRepoRoot(); err == nil {
+		removed += pruneReports(repoRoot, retention)
+		removed += pruneReports(filepath.Join(repoRoot, ".github"), retention)
+	}
+
+	if removed == 0 {
+		ui.Success("✓ Nothing to clean")
+		return
+	}
+	ui.Success(fmt.Sprintf("✓ Removed %d stale file(s)", removed))
+}
+
+// pruneOlderThan removes regular files in dir older than maxAge and
+// returns how many were removed. A missing directory is not an error.
+func pruneOlderThan(dir string, maxAge time.Duration) int {
+	if maxAge <= 0 {
+		return 0
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+
+	removed := 0
+	cutoff := time.Now().Add(-maxAge)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		if err := os.Remove(path); err != nil {
+			continue
+		}
+		if viper.GetBool("verbose") {
+			ui.Muted("  Removed " + path)
+		}
+		removed++
+	}
+	return removed
+}
+
+// pruneMatching removes files in dir matching the glob pattern, regardless
+// of age, and returns how many were removed
+func pruneMatching(dir, pattern string) int {
+	matches, err := filepath.Glob(filepath.Join(dir, pattern))
+	if err != nil {
+		return 0
+	}
+
+	removed := 0
+	for _, path := range matches {
+		if err := os.Remove(path); err != nil {
+			continue
+		}
+		if viper.GetBool("verbose") {
+			ui.Muted("  Removed " + path)
+		}
+		removed++
+	}
+	return removed
+}
+
+// pruneReports removes suggestions_*.md report files in dir older than
+// the retention window
+func pruneReports(dir string, maxAge time.Duration) int {
+	if maxAge <= 0 {
+		return 0
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "suggestions_*.md"))
+	if err != nil {
+		return 0
+	}
+
+	removed := 0
+	cutoff := time.Now().Add(-maxAge)
+	for _, path := range matches {
+		if !strings.HasSuffix(path, ".md") {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			continue
+		}
+		if viper.GetBool("verbose") {
+			ui.Muted("  Removed " + path)
+		}
+		removed++
+	}
+	return removed
+}
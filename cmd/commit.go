@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var commitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "Review staged changes and commit in one step",
+	Long: `Run the full review-then-commit workflow as one command: review the
+staged changes, apply or skip fixes interactively, draft a commit message
+from the final staged diff, let you edit it, and perform the commit.
+
+An explicit -m message skips the drafting step and is used as-is.`,
+	Run: runCommit,
+}
+
+func init() {
+	rootCmd.AddCommand(commitCmd)
+}
+
+func runCommit(cmd *cobra.Command, args []string) {
+	if !git.IsGitRepo() {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	// Review phase: the session applies or skips fixes and stages them.
+	// runReview exits the process when the session is aborted, so reaching
+	// the commit phase means the review was seen through.
+	runReview(cmd, args)
+
+	diff, err := git.StagedDiff()
+	if err != nil {
+		ui.Error(fmt.Sprintf("Could not read the staged diff: %v", err))
+		os.Exit(exitToolFailure)
+	}
+	if strings.TrimSpace(diff) == "" {
+		ui.Info("Nothing staged to commit")
+		return
+	}
+
+	// An explicit -m message is used as-is; otherwise draft one from the
+	// final staged diff, which includes any fixes the session applied
+	message := viper.GetString("message")
+	if message == "" {
+		message = draftCommitMessage(diff)
+	} else {
+		doCommit(message)
+		return
+	}
+
+	// Without a terminal there is nobody to confirm or edit with
+	if !ui.IsInteractive() {
+		ui.Error("No terminal to confirm the commit message - pass one with -m")
+		os.Exit(1)
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	for {
+		fmt.Println()
+		ui.Info("Commit message:")
+		for _, line := range strings.Split(message, "\n") {
+			fmt.Println("  " + line)
+		}
+		fmt.Print("\nCommit with this message? " + ui.Option("y") + "es | " + ui.Option("e") + "dit | " + ui.Option("a") + "bort: ")
+
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			ui.Error("Failed to read input")
+			os.Exit(1)
+		}
+
+		switch strings.TrimSpace(strings.ToLower(input)) {
+		case "y", "yes":
+			doCommit(message)
+			return
+		case "e", "edit":
+			if edited, ok := editCommitMessage(message); ok {
+				message = edited
+			}
+		case "a", "abort", "q":
+			ui.Info("Commit aborted - the staged changes are untouched.")
+			os.Exit(1)
+		}
+	}
+}
+
+// draftCommitMessage asks the model for a commit message describing the
+// staged diff; when no provider is available the user types one instead
+func draftCommitMessage(diff string) string {
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		repoRoot = "."
+	}
+	reviewer, err := review.NewReviewer(review.Options{
+		Model:             viper.GetString("model"),
+		RepoRoot:          repoRoot,
+		Language:          viper.GetString("review_language"),
+		Provider:          viper.GetString("provider"),
+		BaseURL:           viper.GetString("base_url"),
+		APIKey:            viper.GetString("api_key"),
+		LocalParallel:     viper.GetInt("local_max_parallel"),
+		LocalContext:      viper.GetInt("local_max_context"),
+		PersistentSession: viper.GetBool("persistent_session"),
+		RequestTimeout:    viper.GetDuration("request_timeout"),
+		RetryAttempts:     viper.GetInt("retry_attempts"),
+		RetryBackoff:      viper.GetDuration("retry_backoff"),
+		NoCache:           true, // Each draft reflects the current diff
+		ExtraCABundle:     viper.GetString("extra_ca_bundle"),
+	})
+	if err == nil {
+		defer reviewer.Close()
+		ui.Info("📝 Drafting a commit message from the staged diff...")
+		if message, err := reviewer.CommitMessage(diff); err == nil && message != "" {
+			return message
+		} else if viper.GetBool("verbose") && err != nil {
+			ui.Warning(fmt.Sprintf("Could not draft a commit message: %v", err))
+		}
+	} else if viper.GetBool("verbose") {
+		ui.Warning(fmt.Sprintf("Provider unavailable, enter the message manually: %v", err))
+	}
+
+	fmt.Print("Commit message: ")
+	message, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	return strings.TrimSpace(message)
+}
+
+// editCommitMessage opens the message in $EDITOR and returns the edited
+// text; an emptied file cancels the edit
+func editCommitMessage(message string) (string, bool) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		ui.Warning("  $EDITOR is not set")
+		return "", false
+	}
+
+	tmp, err := os.CreateTemp("", "prereview-commit-*.txt")
+	if err != nil {
+		ui.Warning(fmt.Sprintf("  Could not create a temporary file: %v", err))
+		return "", false
+	}
+	path := tmp.Name()
+	defer os.Remove(path)
+
+	if _, err := tmp.WriteString(message + "\n"); err != nil {
+		tmp.Close()
+		ui.Warning(fmt.Sprintf("  Could not write the message: %v", err))
+		return "", false
+	}
+	tmp.Close()
+
+	fields := strings.Fields(editor)
+	cmd := exec.Command(fields[0], append(fields[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		ui.Warning(fmt.Sprintf("  Editor failed: %v", err))
+		return "", false
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		ui.Warning(fmt.Sprintf("  Could not read the edited message: %v", err))
+		return "", false
+	}
+	result := strings.TrimSpace(string(edited))
+	if result == "" {
+		ui.Muted("  Empty message - keeping the previous one.")
+		return "", false
+	}
+	return result, true
+}
+
+// doCommit performs the commit and reports the result
+func doCommit(message string) {
+	if message == "" {
+		ui.Error("Empty commit message - nothing committed")
+		os.Exit(1)
+	}
+	if err := git.Commit(message); err != nil {
+		ui.Error(err.Error())
+		os.Exit(exitToolFailure)
+	}
+	ui.Success("✓ Committed: " + strings.SplitN(message, "\n", 2)[0])
+}
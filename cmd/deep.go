@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/output"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var deepCmd = &cobra.Command{
+	Use:   "deep [file]",
+	Short: "Run an intensive multi-pass review of a single file",
+	Long: `Run an intensive review of a single file with separate focused passes
+for security, performance, and maintainability.
+
+Unlike the normal staged-changes review, this reviews the full file content
+from the working tree, making it useful before modifying a gnarly legacy file.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runDeep,
+}
+
+func init() {
+	rootCmd.AddCommand(deepCmd)
+	deepCmd.Flags().Bool("markdown", false, "Output the report to a markdown file instead of the terminal")
+}
+
+func runDeep(cmd *cobra.Command, args []string) {
+	path := args[0]
+
+	// Check the file exists and is readable
+	content, err := git.ReadFileContent(path)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to read file %s: %v", path, err))
+		os.Exit(1)
+	}
+
+	// Get repo root for standards detection (deep review also works outside git)
+	repoRoot := ""
+	if git.IsGitRepo() {
+		repoRoot, err = git.GetRepoRoot()
+		if err != nil {
+			ui.Warning("Could not determine repository root")
+			repoRoot = "."
+		}
+	}
+
+	ui.Info(fmt.Sprintf("🔬 Deep reviewing %s (%d passes)...\n", path, len(review.DeepPasses)))
+
+	reviewer, err := review.NewReviewer(review.Options{
+		Model:           viper.GetString("model"),
+		RepoRoot:        repoRoot,
+		CustomStandards: viper.GetStringSlice("coding_standards"),
+		ProjectHints:    viper.GetStringSlice("project_hints"),
+		Tolerance:       viper.GetString("tolerance"),
+		Profile:         viper.GetString("profile"),
+		MaxPromptTokens: viper.GetInt("max_prompt_tokens"),
+		RequestTimeout:  viper.GetDuration("request_timeout"),
+		NoCache:         viper.GetBool("no_cache"),
+		CacheTTL:        viper.GetDuration("cache_ttl"),
+	})
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to initialize reviewer: %v", err))
+		os.Exit(1)
+	}
+	defer reviewer.Close()
+
+	ctx, stop := interruptibleContext()
+	defer stop()
+	result, err := reviewer.DeepReview(ctx, path, content)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			ui.Info("\nDeep review cancelled")
+			os.Exit(1)
+		}
+		exitOnProviderError(err)
+		ui.Error(fmt.Sprintf("Deep review failed: %v", err))
+		os.Exit(1)
+	}
+
+	if len(result.Suggestions) == 0 {
+		ui.Success("✓ No issues found! This file looks good.")
+		return
+	}
+
+	// Generate markdown report if requested
+	if markdown, _ := cmd.Flags().GetBool("markdown"); markdown {
+		generator := output.NewMarkdownGenerator(repoRoot)
+		filePath, err := generator.GenerateSuggestionsFile(result)
+		if err != nil {
+			ui.Error(fmt.Sprintf("Failed to generate markdown file: %v", err))
+			os.Exit(1)
+		}
+		ui.Success(fmt.Sprintf("✓ Generated report: %s", filePath))
+		ui.Info(fmt.Sprintf("  Found %d suggestion(s)", len(result.Suggestions)))
+		return
+	}
+
+	printDeepReport(result)
+}
+
+// printDeepReport prints a structured per-pass report to the terminal
+func printDeepReport(result *review.ReviewResult) {
+	// Count findings by category so the report mirrors the pass structure
+	byCategory := make(map[string][]review.Suggestion)
+	for _, s := range result.Suggestions {
+		byCategory[s.Category] = append(byCategory[s.Category], s)
+	}
+
+	ui.Divider()
+	ui.Title(fmt.Sprintf("Deep Review Report — %d finding(s)", len(result.Suggestions)))
+
+	// Print pass categories first, in pass order, then any remaining categories
+	printed := make(map[string]bool)
+	order := []string{"security", "performance", "best-practice"}
+	for _, category := range order {
+		printCategoryFindings(category, byCategory[category])
+		printed[category] = true
+	}
+	for category, suggestions := range byCategory {
+		if !printed[category] {
+			printCategoryFindings(category, suggestions)
+		}
+	}
+
+	ui.Divider()
+	ui.Info("Run 'prereview' after staging changes to review and apply fixes interactively.")
+}
+
+// printCategoryFindings prints the findings for one category section
+func printCategoryFindings(category string, suggestions []review.Suggestion) {
+	if len(suggestions) == 0 {
+		return
+	}
+
+	fmt.Println()
+	ui.Info(fmt.Sprintf("▸ %s (%d)", category, len(suggestions)))
+
+	for _, s := range suggestions {
+		icon := ui.SeverityIcon(string(s.Severity))
+		location := ""
+		if s.Line > 0 {
+			location = fmt.Sprintf(" (line %d)", s.Line)
+			if s.EndLine > s.Line {
+				location = fmt.Sprintf(" (lines %d-%d)", s.Line, s.EndLine)
+			}
+		}
+		fmt.Printf("  %s %s%s\n", icon, s.Title, location)
+		if s.Description != "" {
+			ui.Muted("     " + s.Description)
+		}
+	}
+}
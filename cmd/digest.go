@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/output"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Generate a team-facing digest of recent review activity",
+	Long: `Aggregate review history into a digest - top categories, notable security
+findings, fix rates - suitable for pasting into a team channel or wiki.
+
+By default the current repository's history is used; list additional
+repositories under digest_repos in the config to aggregate across them.`,
+	Run: runDigest,
+}
+
+func init() {
+	rootCmd.AddCommand(digestCmd)
+	digestCmd.Flags().String("since", "7d", "How far back to aggregate (e.g. 7d, 24h)")
+	digestCmd.Flags().Bool("html", false, "Render HTML instead of markdown")
+	digestCmd.Flags().String("output", "", "Write the digest to a file instead of stdout")
+}
+
+func runDigest(cmd *cobra.Command, args []string) {
+	sinceSpec, _ := cmd.Flags().GetString("since")
+	window, err := parseSince(sinceSpec)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Invalid --since value %q: %v", sinceSpec, err))
+		os.Exit(1)
+	}
+	since := time.Now().Add(-window)
+
+	repos := make(map[string][]review.HistoryRecord)
+
+	// The current repository, when we're in one
+	if git.IsGitRepo() {
+		if dir, err := git.PrereviewDir(); err == nil {
+			records, err := review.LoadHistory(dir, since)
+			if err == nil && len(records) > 0 {
+				repos[repoLabel()] = records
+			}
+		}
+	}
+
+	// Additional repositories from config
+	for _, repoPath := range viper.GetStringSlice("digest_repos") {
+		dir := filepath.Join(repoPath, ".git", "prereview")
+		records, err := review.LoadHistory(dir, since)
+		if err != nil || len(records) == 0 {
+			continue
+		}
+		repos[filepath.Base(repoPath)] = records
+	}
+
+	if len(repos) == 0 {
+		ui.Info(fmt.Sprintf("No review history in the last %s", sinceSpec))
+		return
+	}
+
+	html, _ := cmd.Flags().GetBool("html")
+	digest := output.GenerateDigest(output.DigestData{Since: since, Repos: repos}, html)
+
+	if outPath, _ := cmd.Flags().GetString("output"); outPath != "" {
+		if err := os.WriteFile(outPath, []byte(digest), 0644); err != nil {
+			ui.Error(fmt.Sprintf("Failed to write digest: %v", err))
+			os.Exit(1)
+		}
+		ui.Success("✓ Wrote digest to " + outPath)
+		return
+	}
+
+	fmt.Print(digest)
+}
+
+// parseSince parses a lookback window: a day count like "7d", or any
+// duration time.ParseDuration accepts
+func parseSince(spec string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(spec, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("expected a positive day count like 7d")
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(spec)
+}
+
+// repoLabel names the current repository for digest output
+func repoLabel() string {
+	root, err := git.GetRepoRoot()
+	if err != nil {
+		return "current"
+	}
+	return filepath.Base(root)
+}
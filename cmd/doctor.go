@@ -1,14 +1,18 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/llm"
 	"github.com/emilushi/prereview/internal/ui"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var doctorCmd = &cobra.Command{
@@ -57,6 +61,9 @@ func runDoctor(cmd *cobra.Command, args []string) {
 	// Check 4: Copilot CLI authenticated
 	results = append(results, checkCopilotAuth())
 
+	// Check 5: Configured provider answers a health check
+	results = append(results, checkProviderHealth())
+
 	// Print results
 	for _, r := range results {
 		if r.ok {
@@ -149,7 +156,7 @@ func checkGitRepo() checkResult {
 func checkCopilotInstalled() checkResult {
 	// Try different possible command names
 	commands := []string{"copilot", "github-copilot-cli"}
-	
+
 	for _, cmd := range commands {
 		path, err := exec.LookPath(cmd)
 		if err == nil {
@@ -203,7 +210,7 @@ func checkCopilotAuth() checkResult {
 				help:    getCopilotAuthHelp(copilotCmd),
 			}
 		}
-		
+
 		// Unknown error
 		return checkResult{
 			name:    "Copilot authentication",
@@ -233,6 +240,56 @@ func checkCopilotAuth() checkResult {
 	}
 }
 
+// checkProviderHealth starts the configured provider and pings it, the
+// same check a review runs at warm-up, so doctor catches expired auth or
+// an inactive subscription rather than just a missing binary
+func checkProviderHealth() checkResult {
+	name := viper.GetString("provider")
+	if name == "" {
+		name = "copilot"
+	}
+
+	provider, err := llm.New(llm.Options{
+		Provider:      name,
+		BaseURL:       viper.GetString("base_url"),
+		APIKey:        viper.GetString("api_key"),
+		ExtraCABundle: viper.GetString("extra_ca_bundle"),
+	})
+	if err != nil {
+		return checkResult{
+			name:    "Provider health",
+			ok:      false,
+			message: fmt.Sprintf("%s failed to start: %v", name, err),
+		}
+	}
+	defer provider.Close()
+
+	checker, ok := provider.(llm.HealthChecker)
+	if !ok {
+		return checkResult{
+			name:    "Provider health",
+			ok:      true,
+			message: fmt.Sprintf("%s started (no health check available)", name),
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	if err := checker.Ping(ctx); err != nil {
+		return checkResult{
+			name:    "Provider health",
+			ok:      false,
+			message: fmt.Sprintf("%s: %v", name, err),
+		}
+	}
+
+	return checkResult{
+		name:    "Provider health",
+		ok:      true,
+		message: fmt.Sprintf("%s is reachable and authenticated", name),
+	}
+}
+
 func findCopilotCommand() string {
 	commands := []string{"copilot", "github-copilot-cli"}
 	for _, cmd := range commands {
@@ -245,7 +302,7 @@ func findCopilotCommand() string {
 
 func getCopilotInstallHelp() string {
 	os := runtime.GOOS
-	
+
 	var sb strings.Builder
 	sb.WriteString("  Install Copilot CLI:\n\n")
 
@@ -287,7 +344,7 @@ func getCopilotAuthHelp(copilotCmd string) string {
 
 func getGitInstallHelp() string {
 	os := runtime.GOOS
-	
+
 	var sb strings.Builder
 	sb.WriteString("  Install Git:\n\n")
 
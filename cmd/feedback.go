@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var feedbackCmd = &cobra.Command{
+	Use:   "feedback",
+	Short: "Summarize recorded feedback on past findings",
+	Long: `Summarize the useful / false-positive votes recorded with the
++ and - session actions: overall counts, a per-category breakdown, and
+the hints and tolerance the feedback currently derives for future
+reviews.
+
+Export the raw votes for maintainers with:
+  prereview feedback --export feedback.json`,
+	Run: runFeedback,
+}
+
+func init() {
+	rootCmd.AddCommand(feedbackCmd)
+	feedbackCmd.Flags().String("export", "", "Write all recorded votes to this JSON file")
+}
+
+func runFeedback(cmd *cobra.Command, args []string) {
+	dir, err := git.PrereviewDir()
+	if err != nil {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	records, err := review.LoadFeedback(dir)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to load feedback: %v", err))
+		os.Exit(1)
+	}
+	if len(records) == 0 {
+		ui.Info("No feedback recorded yet. Vote with + and - during a review session.")
+		return
+	}
+
+	if path, _ := cmd.Flags().GetString("export"); path != "" {
+		data, err := json.MarshalIndent(records, "", "  ")
+		if err != nil {
+			ui.Error(fmt.Sprintf("Failed to encode feedback: %v", err))
+			os.Exit(1)
+		}
+		if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+			ui.Error(fmt.Sprintf("Failed to write %s: %v", path, err))
+			os.Exit(1)
+		}
+		ui.Success(fmt.Sprintf("✓ Exported %d vote(s) to %s", len(records), path))
+		return
+	}
+
+	useful := 0
+	votes := make(map[string]int)
+	rejected := make(map[string]int)
+	for _, record := range records {
+		if record.Useful {
+			useful++
+		} else if record.Category != "" {
+			rejected[record.Category]++
+		}
+		if record.Category != "" {
+			votes[record.Category]++
+		}
+	}
+
+	ui.Info(fmt.Sprintf("📊 %d vote(s) recorded\n", len(records)))
+	fmt.Printf("  Useful:          %d\n", useful)
+	fmt.Printf("  False positives: %d\n", len(records)-useful)
+
+	if len(votes) > 0 {
+		names := make([]string, 0, len(votes))
+		for name := range votes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Println()
+		ui.Info("By category:")
+		for _, name := range names {
+			fmt.Printf("  %-20s %d vote(s), %d false positive(s)\n", name, votes[name], rejected[name])
+		}
+	}
+
+	// What this feedback currently changes about future reviews
+	hints := review.FeedbackHints(records)
+	suggested := review.SuggestedTolerance(records)
+	if len(hints) > 0 || suggested != "" {
+		fmt.Println()
+		ui.Info("Derived tuning:")
+		for _, hint := range hints {
+			fmt.Printf("  • %s\n", hint)
+		}
+		if suggested != "" {
+			fmt.Printf("  • Suggested tolerance: %s\n", suggested)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Browse past review runs in this repository",
+	Long: `List past review runs recorded in this repository's history
+database (.git/prereview/history.db): when each ran, what it found, and
+how many findings were fixed versus skipped.
+
+A footer shows the categories that keep coming back, so recurring issue
+patterns stand out.`,
+	Run: runHistory,
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().IntP("limit", "n", 20, "Show at most this many runs, newest first")
+	historyCmd.Flags().Int("days", 0, "Only show runs from the last N days (0 = all)")
+}
+
+func runHistory(cmd *cobra.Command, args []string) {
+	dir, err := git.PrereviewDir()
+	if err != nil {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	since := time.Time{}
+	if days, _ := cmd.Flags().GetInt("days"); days > 0 {
+		since = time.Now().AddDate(0, 0, -days)
+	}
+
+	records, err := review.LoadHistory(dir, since)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to load history: %v", err))
+		os.Exit(1)
+	}
+	if len(records) == 0 {
+		ui.Info("No review history yet. Run a review first.")
+		return
+	}
+
+	// Newest first for browsing
+	sort.Slice(records, func(i, j int) bool { return records[i].Time.After(records[j].Time) })
+
+	limit, _ := cmd.Flags().GetInt("limit")
+	shown := records
+	if limit > 0 && len(shown) > limit {
+		shown = shown[:limit]
+	}
+
+	ui.Info(fmt.Sprintf("📜 %d review run(s) recorded\n", len(records)))
+	for _, record := range shown {
+		line := fmt.Sprintf("  %s  %d file(s), %d finding(s)",
+			record.Time.Format("2006-01-02 15:04"), record.Files, len(record.Findings))
+		if record.Fixed > 0 || record.Skipped > 0 {
+			line += fmt.Sprintf(", %d fixed / %d skipped", record.Fixed, record.Skipped)
+		}
+		if record.Duration > 0 {
+			line += fmt.Sprintf(", %.1fs", record.Duration)
+		}
+		if record.Tokens > 0 {
+			line += fmt.Sprintf(", %d tokens", record.Tokens)
+		}
+		fmt.Println(line)
+	}
+	if len(shown) < len(records) {
+		ui.Muted(fmt.Sprintf("  ... and %d older run(s) (use -n to show more)", len(records)-len(shown)))
+	}
+
+	// Recurring categories across the listed period
+	categories := make(map[string]int)
+	for _, record := range records {
+		for _, f := range record.Findings {
+			if f.Category != "" {
+				categories[f.Category]++
+			}
+		}
+	}
+	if len(categories) > 0 {
+		names := make([]string, 0, len(categories))
+		for name := range categories {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if categories[names[i]] != categories[names[j]] {
+				return categories[names[i]] > categories[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		if len(names) > 5 {
+			names = names[:5]
+		}
+		fmt.Println()
+		ui.Info("Recurring categories:")
+		for _, name := range names {
+			fmt.Printf("  %-20s %d\n", name, categories[name])
+		}
+	}
+}
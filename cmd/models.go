@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/emilushi/prereview/internal/llm"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var modelsCmd = &cobra.Command{
+	Use:   "models",
+	Short: "List models available from the active provider",
+	Long: `Query the active provider (Copilot CLI, OpenAI-compatible endpoint, or
+Ollama) for the models actually available to you, and mark the one that is
+currently configured.`,
+	Run: runModels,
+}
+
+func init() {
+	rootCmd.AddCommand(modelsCmd)
+}
+
+func runModels(cmd *cobra.Command, args []string) {
+	provider, err := llm.New(llm.Options{
+		Provider:      viper.GetString("provider"),
+		BaseURL:       viper.GetString("base_url"),
+		APIKey:        viper.GetString("api_key"),
+		ExtraCABundle: viper.GetString("extra_ca_bundle"),
+	})
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to start provider: %v", err))
+		os.Exit(1)
+	}
+	defer provider.Close()
+
+	lister, ok := provider.(llm.ModelLister)
+	if !ok {
+		ui.Error(fmt.Sprintf("Provider '%s' does not support listing models", viper.GetString("provider")))
+		os.Exit(1)
+	}
+
+	models, err := lister.ListModels()
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to list models: %v", err))
+		os.Exit(1)
+	}
+	if len(models) == 0 {
+		ui.Info("No models available")
+		return
+	}
+
+	sort.Slice(models, func(i, j int) bool { return models[i].ID < models[j].ID })
+
+	// The configured model may be a friendly alias; resolve it the same way
+	// the Copilot client would so the marker lands on the right entry
+	configured := viper.GetString("model")
+	resolved := configured
+	if name := viper.GetString("provider"); name == "" || name == "copilot" {
+		resolved = llm.CopilotModelID(configured)
+	}
+
+	ui.Title(fmt.Sprintf("Available models (%s)", providerLabel()))
+	for _, model := range models {
+		marker := "  "
+		if model.ID == configured || model.ID == resolved {
+			marker = ui.Option("*") + " "
+		}
+		line := marker + model.ID
+		if model.Name != "" && model.Name != model.ID {
+			line += "  (" + model.Name + ")"
+		}
+		fmt.Println("  " + line)
+	}
+	ui.Muted(fmt.Sprintf("\n  * = configured model (%s)", configured))
+}
+
+// providerLabel names the active provider for display
+func providerLabel() string {
+	if name := viper.GetString("provider"); name != "" {
+		return name
+	}
+	return "copilot"
+}
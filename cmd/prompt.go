@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/source"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var promptCmd = &cobra.Command{
+	Use:   "prompt",
+	Short: "Inspect the prompts prereview sends",
+	Long:  `Debugging helpers for inspecting the prompts prereview builds for the AI provider.`,
+}
+
+var promptPreviewCmd = &cobra.Command{
+	Use:   "preview [file]",
+	Short: "Print the exact prompt that would be sent for a staged file",
+	Long: `Print the exact review prompt that would be sent to the provider for a
+staged file - including coding standards context, project hints, tolerance
+instructions, and secret redaction - without calling the provider.
+
+Useful for debugging why the model is (or isn't) flagging something, and
+for auditing what leaves the machine.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runPromptPreview,
+}
+
+func init() {
+	rootCmd.AddCommand(promptCmd)
+	promptCmd.AddCommand(promptPreviewCmd)
+}
+
+func runPromptPreview(cmd *cobra.Command, args []string) {
+	src, err := source.ForName(viper.GetString("source"), viper.GetString("source_dir"))
+	if err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+	if !src.Available() {
+		ui.Error(fmt.Sprintf("Change source '%s' is not available here", src.Name()))
+		os.Exit(1)
+	}
+
+	repoRoot, err := src.Root()
+	if err != nil {
+		repoRoot = "."
+	}
+
+	changes, err := src.Changes()
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to get changes: %v", err))
+		os.Exit(1)
+	}
+
+	var change *git.FileChange
+	for i := range changes {
+		if changes[i].Path == args[0] {
+			change = &changes[i]
+			break
+		}
+	}
+	if change == nil {
+		ui.Error(fmt.Sprintf("No staged change for %s", args[0]))
+		os.Exit(1)
+	}
+
+	commitMessage := viper.GetString("message")
+	if commitMessage == "" && src.Name() == "git" {
+		commitMessage = git.ReadPreparedCommitMessage()
+	}
+
+	// A prompt-only reviewer never contacts the provider, so preview works
+	// offline and without credentials
+	reviewer, err := review.NewPromptPreviewer(review.Options{
+		Model:           viper.GetString("model"),
+		RepoRoot:        repoRoot,
+		CustomStandards: viper.GetStringSlice("coding_standards"),
+		ProjectHints:    viper.GetStringSlice("project_hints"),
+		Tolerance:       viper.GetString("tolerance"),
+		Profile:         viper.GetString("profile"),
+		MaxPromptTokens: viper.GetInt("max_prompt_tokens"),
+		IncludeRelated:  viper.GetBool("related_context"),
+		CommitMessage:   commitMessage,
+		SuggestTests:    viper.GetBool("suggest_tests"),
+		Language:        viper.GetString("review_language"),
+		Analyzers:       viper.GetStringSlice("analyzers"),
+	})
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to initialize reviewer: %v", err))
+		os.Exit(1)
+	}
+
+	prompt, err := reviewer.PreviewPrompt(*change)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to build prompt: %v", err))
+		os.Exit(1)
+	}
+
+	fmt.Println(prompt)
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/publish"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var publishCmd = &cobra.Command{
+	Use:   "publish",
+	Short: "Post the last review to a pull or merge request",
+	Long: `Post the most recent review result to a pull request (GitHub) or
+merge request (GitLab) as inline code review comments.
+
+Suggestions that fall inside the diff become inline comments (with
+suggested-change blocks where a fix is available); the rest are listed
+in the summary comment.
+
+Requires a token in the GITHUB_TOKEN or GITLAB_TOKEN environment variable:
+  prereview publish --github --pr 42
+  prereview publish --gitlab --pr 42`,
+	Run: runPublish,
+}
+
+func init() {
+	rootCmd.AddCommand(publishCmd)
+
+	publishCmd.Flags().Bool("github", false, "Publish as a GitHub pull request review")
+	publishCmd.Flags().Bool("gitlab", false, "Publish as GitLab merge request discussions")
+	publishCmd.Flags().Int("pr", 0, "Pull or merge request number to publish to")
+	publishCmd.Flags().String("repo", "", "Repository as owner/repo (default: detected from the origin remote)")
+}
+
+func runPublish(cmd *cobra.Command, args []string) {
+	github, _ := cmd.Flags().GetBool("github")
+	gitlab, _ := cmd.Flags().GetBool("gitlab")
+	if github == gitlab {
+		ui.Error("Specify exactly one publish target: --github or --gitlab")
+		os.Exit(1)
+	}
+
+	dir, err := git.PrereviewDir()
+	if err != nil {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	result, err := review.LoadResult(dir)
+	if err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+	if len(result.Suggestions) == 0 {
+		ui.Info("The last review found no suggestions - nothing to publish.")
+		return
+	}
+
+	wantHost := "github"
+	if gitlab {
+		wantHost = "gitlab"
+	}
+
+	repo, _ := cmd.Flags().GetString("repo")
+	if repo == "" {
+		host, project, err := git.OriginRepo()
+		if err == nil && host == wantHost {
+			repo = project
+		}
+	}
+
+	pr, _ := cmd.Flags().GetInt("pr")
+
+	var summary *publish.Summary
+	if github {
+		token := os.Getenv("GITHUB_TOKEN")
+		if token == "" {
+			token = os.Getenv("GH_TOKEN")
+		}
+		publisher, err := publish.NewGitHubPublisher(publish.GitHubOptions{
+			Token: token,
+			Repo:  repo,
+			PR:    pr,
+		})
+		if err != nil {
+			ui.Error(err.Error())
+			os.Exit(1)
+		}
+		ui.Info(fmt.Sprintf("📤 Publishing %d suggestion(s) to %s#%d...", len(result.Suggestions), repo, pr))
+		summary, err = publisher.Publish(context.Background(), result)
+		if err != nil {
+			ui.Error(fmt.Sprintf("Failed to publish review: %v", err))
+			os.Exit(1)
+		}
+	} else {
+		// GitLab CI exports CI_SERVER_URL, which covers self-hosted instances
+		baseURL := ""
+		if server := os.Getenv("CI_SERVER_URL"); server != "" {
+			baseURL = strings.TrimSuffix(server, "/") + "/api/v4"
+		}
+		publisher, err := publish.NewGitLabPublisher(publish.GitLabOptions{
+			Token:   os.Getenv("GITLAB_TOKEN"),
+			Project: repo,
+			MR:      pr,
+			BaseURL: baseURL,
+		})
+		if err != nil {
+			ui.Error(err.Error())
+			os.Exit(1)
+		}
+		ui.Info(fmt.Sprintf("📤 Publishing %d suggestion(s) to %s!%d...", len(result.Suggestions), repo, pr))
+		summary, err = publisher.Publish(context.Background(), result)
+		if err != nil {
+			ui.Error(fmt.Sprintf("Failed to publish review: %v", err))
+			os.Exit(1)
+		}
+	}
+
+	ui.Success(fmt.Sprintf("✓ Posted review with %d inline comment(s)", summary.Inline))
+	if summary.Outside > 0 {
+		ui.Muted(fmt.Sprintf("  %d suggestion(s) were outside the diff and listed in the summary comment", summary.Outside))
+	}
+	if summary.URL != "" {
+		ui.Muted("  " + summary.URL)
+	}
+}
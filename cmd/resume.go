@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var resumeCmd = &cobra.Command{
+	Use:   "resume [session-file]",
+	Short: "Resume an interrupted review session",
+	Long: `Resume the most recent interactive review session where it left off.
+
+When a session is interrupted (terminal closed, crash, Ctrl-C), its progress
+- current position, decisions made, applied fixes - is persisted. Resume
+restores that state instead of re-reviewing and re-asking everything.
+
+With a file argument, resume a session exported via --save-session
+instead of the one persisted in this repository:
+  prereview resume session.json`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runResume,
+}
+
+func init() {
+	rootCmd.AddCommand(resumeCmd)
+}
+
+func runResume(cmd *cobra.Command, args []string) {
+	if !git.IsGitRepo() {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	var session *ui.ReviewSession
+	if len(args) == 1 {
+		// Resume from an exported session file (--save-session)
+		var err error
+		session, err = ui.LoadSessionExport(args[0], viper.GetBool("verbose"))
+		if err != nil {
+			ui.Error(err.Error())
+			os.Exit(1)
+		}
+		// Keep mirroring progress to the same file as decisions are made
+		session.SetExportPath(args[0])
+	} else {
+		dir, err := git.PrereviewDir()
+		if err != nil {
+			ui.Error(fmt.Sprintf("Could not locate prereview data directory: %v", err))
+			os.Exit(1)
+		}
+
+		result, err := review.LoadResult(dir)
+		if err != nil {
+			ui.Error(err.Error())
+			os.Exit(1)
+		}
+
+		session = ui.NewReviewSession(result, viper.GetBool("verbose"))
+		session.SetStateDir(dir)
+		session.SetFeedbackSink(func(sug review.Suggestion, useful bool) {
+			_ = review.AppendFeedback(dir, review.NewFeedbackRecord(sug, useful))
+		})
+		if !session.Restore() {
+			ui.Info("No interrupted session to resume - run 'prereview' to start a new review")
+			return
+		}
+	}
+
+	// A reviewer backs the e(xplain) and verify_fixes actions; resuming
+	// still works without one if the provider can't start
+	repoRoot, rootErr := git.GetRepoRoot()
+	if rootErr != nil {
+		repoRoot = "."
+	}
+	reviewer, err := review.NewReviewer(review.Options{
+		Model:             viper.GetString("model"),
+		RepoRoot:          repoRoot,
+		CustomStandards:   viper.GetStringSlice("coding_standards"),
+		ProjectHints:      viper.GetStringSlice("project_hints"),
+		Tolerance:         viper.GetString("tolerance"),
+		Profile:           viper.GetString("profile"),
+		Language:          viper.GetString("review_language"),
+		Provider:          viper.GetString("provider"),
+		BaseURL:           viper.GetString("base_url"),
+		APIKey:            viper.GetString("api_key"),
+		LocalParallel:     viper.GetInt("local_max_parallel"),
+		LocalContext:      viper.GetInt("local_max_context"),
+		PersistentSession: viper.GetBool("persistent_session"),
+		RequestTimeout:    viper.GetDuration("request_timeout"),
+		RetryAttempts:     viper.GetInt("retry_attempts"),
+		RetryBackoff:      viper.GetDuration("retry_backoff"),
+		NoCache:           viper.GetBool("no_cache"),
+		CacheTTL:          viper.GetDuration("cache_ttl"),
+		ExtraCABundle:     viper.GetString("extra_ca_bundle"),
+	})
+	if err == nil {
+		defer reviewer.Close()
+		session.SetExplainer(reviewer.Explain)
+		if viper.GetBool("verify_fixes") {
+			session.SetVerifier(reviewer.VerifyFix)
+		}
+	} else if viper.GetBool("verbose") {
+		ui.Warning(fmt.Sprintf("Provider unavailable, explain disabled: %v", err))
+	}
+
+	session.SetEditorCommand(viper.GetString("editor_command"))
+	session.SetKeybindings(viper.GetStringMapString("keybindings"))
+
+	ui.Info("🔄 Resuming review session...\n")
+	outcome := session.Run()
+
+	switch outcome.Action {
+	case ui.ActionCommit:
+		ui.Success(fmt.Sprintf("\n✓ Review complete: %d fixed, %d skipped", outcome.Fixed, outcome.Skipped))
+	case ui.ActionReReview:
+		ui.Info("\nRun 'prereview' to re-review the changes.")
+	default:
+		ui.Info("\n✗ Review aborted")
+		os.Exit(1)
+	}
+}
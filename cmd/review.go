@@ -1,14 +1,23 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
 
+	"github.com/emilushi/prereview/internal/gate"
 	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/llm"
 	"github.com/emilushi/prereview/internal/output"
 	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/source"
 	"github.com/emilushi/prereview/internal/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -17,6 +26,10 @@ import (
 // DefaultMaxFileSize is the default maximum file size to review (100KB)
 const DefaultMaxFileSize int64 = 100000
 
+// reReviewFiles, when set, limits the next review pass to the files that
+// applied fixes modified; it is consumed by the pass that uses it
+var reReviewFiles map[string]bool
+
 var reviewCmd = &cobra.Command{
 	Use:   "review",
 	Short: "Review staged changes",
@@ -27,28 +40,57 @@ var reviewCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(reviewCmd)
 	reviewCmd.Flags().Bool("markdown", false, "Output suggestions to a markdown file instead of interactive mode")
+	reviewCmd.Flags().String("baseline", "", "Baseline file of known findings; only new findings count (CI ratchet)")
+	reviewCmd.Flags().String("save-baseline", "", "Write the run's findings to a baseline file for future ratcheting")
+	reviewCmd.Flags().String("progress", "", "Progress reporting for non-interactive runs: plain, json, off (default: plain when not attached to a terminal)")
+	reviewCmd.Flags().String("format", "", "Machine-readable output format: json, github, junit, checkstyle, tap (disables interactive mode)")
+	reviewCmd.Flags().StringP("output", "o", "", "Write formatted output to a file instead of stdout")
+	reviewCmd.Flags().String("fail-on", "", "Exit non-zero on findings at or above this severity: error, warning, any")
+	reviewCmd.Flags().String("save-session", "", "Mirror the interactive session to a file resumable with 'prereview resume <file>'")
+	reviewCmd.Flags().String("auto-fix", "", "Apply fixes at or above this confidence without prompting: high, medium, low")
+	reviewCmd.Flags().Lookup("auto-fix").NoOptDefVal = "high"
+	reviewCmd.Flags().Bool("web", false, "Serve the session in the browser instead of the terminal (for GUI git clients)")
 	viper.BindPFlag("output_markdown", reviewCmd.Flags().Lookup("markdown"))
+	viper.BindPFlag("fail_on", reviewCmd.Flags().Lookup("fail-on"))
+	viper.BindPFlag("format", reviewCmd.Flags().Lookup("format"))
+	viper.BindPFlag("output_file", reviewCmd.Flags().Lookup("output"))
+	viper.BindPFlag("progress", reviewCmd.Flags().Lookup("progress"))
+	viper.BindPFlag("baseline", reviewCmd.Flags().Lookup("baseline"))
+	viper.BindPFlag("save_baseline", reviewCmd.Flags().Lookup("save-baseline"))
+	viper.BindPFlag("save_session", reviewCmd.Flags().Lookup("save-session"))
+	viper.BindPFlag("auto_fix", reviewCmd.Flags().Lookup("auto-fix"))
+	viper.BindPFlag("web", reviewCmd.Flags().Lookup("web"))
 }
 
 func runReview(cmd *cobra.Command, args []string) {
-	// Check if we're in a git repository
-	if !git.IsGitRepo() {
-		ui.Error("Not a git repository")
-		os.Exit(1)
+	// Select the change source (git staged changes by default)
+	src, err := source.ForName(viper.GetString("source"), viper.GetString("source_dir"))
+	if err != nil {
+		ui.Error(err.Error())
+		os.Exit(exitToolFailure)
+	}
+
+	if !src.Available() {
+		if src.Name() == "git" {
+			ui.Error("Not a git repository")
+		} else {
+			ui.Error(fmt.Sprintf("Change source '%s' is not available here", src.Name()))
+		}
+		os.Exit(exitToolFailure)
 	}
 
-	// Get repo root for standards detection
-	repoRoot, err := git.GetRepoRoot()
+	// Get root for standards detection
+	repoRoot, err := src.Root()
 	if err != nil {
 		ui.Warning("Could not determine repository root")
 		repoRoot = "."
 	}
 
-	// Get staged changes
-	changes, err := git.GetStagedChanges()
+	// Get pending changes
+	changes, err := src.Changes()
 	if err != nil {
-		ui.Error(fmt.Sprintf("Failed to get staged changes: %v", err))
-		os.Exit(1)
+		ui.Error(fmt.Sprintf("Failed to get changes: %v", err))
+		os.Exit(exitToolFailure)
 	}
 
 	// Filter out ignored files
@@ -58,18 +100,29 @@ func runReview(cmd *cobra.Command, args []string) {
 		maxFileSize = DefaultMaxFileSize
 	}
 
+	// A targeted re-review only looks at files modified by applied fixes
+	onlyFiles := reReviewFiles
+	reReviewFiles = nil
+
 	filteredChanges := make([]git.FileChange, 0, len(changes))
 	for _, change := range changes {
+		if onlyFiles != nil && !onlyFiles[change.Path] {
+			continue
+		}
 		if shouldIgnoreFile(change.Path, ignorePatterns) {
 			if viper.GetBool("verbose") {
 				ui.Info(fmt.Sprintf("  Skipping ignored file: %s", change.Path))
 			}
 			continue
 		}
-		// Check file size
-		if int64(len(change.Content)) > maxFileSize {
+		// Check file size (lazy sources report Size without loading content)
+		size := change.Size
+		if size == 0 {
+			size = int64(len(change.Content))
+		}
+		if size > maxFileSize {
 			if viper.GetBool("verbose") {
-				ui.Info(fmt.Sprintf("  Skipping large file: %s (%d bytes)", change.Path, len(change.Content)))
+				ui.Info(fmt.Sprintf("  Skipping large file: %s (%d bytes)", change.Path, size))
 			}
 			continue
 		}
@@ -82,26 +135,200 @@ func runReview(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	// Degraded offline mode: deterministic checks only, no provider
+	if viper.GetBool("offline") {
+		runOfflineReview(repoRoot, changes)
+		return
+	}
+
 	ui.Info(fmt.Sprintf("🔍 Reviewing %d changed file(s)...\n", len(changes)))
 
-	// Get custom coding standards from config
-	customStandards := viper.GetStringSlice("coding_standards")
+	// Intent context: an explicit --message wins, otherwise fall back to
+	// the commit message git is preparing (if any)
+	commitMessage := viper.GetString("message")
+	if commitMessage == "" && src.Name() == "git" {
+		commitMessage = git.ReadPreparedCommitMessage()
+	}
+
+	// Recorded feedback tunes the review: categories the team keeps voting
+	// down become prompt hints, and a lopsided false-positive rate adjusts
+	// the tolerance (unless one was set explicitly)
 	projectHints := viper.GetStringSlice("project_hints")
 	tolerance := viper.GetString("tolerance")
+	if src.Name() == "git" {
+		if dir, err := git.PrereviewDir(); err == nil {
+			if records, err := review.LoadFeedback(dir); err == nil && len(records) > 0 {
+				projectHints = append(projectHints, review.FeedbackHints(records)...)
+				if suggested := review.SuggestedTolerance(records); suggested != "" && !viper.IsSet("tolerance") {
+					ui.Muted(fmt.Sprintf("  Tolerance set to %s based on recorded feedback", suggested))
+					tolerance = suggested
+				}
+			}
+		}
+	}
 
 	// Create reviewer with coding standards context
-	reviewer, err := review.NewReviewer(viper.GetString("model"), repoRoot, customStandards, projectHints, tolerance)
+	reviewer, err := review.NewReviewer(review.Options{
+		Model:             viper.GetString("model"),
+		RepoRoot:          repoRoot,
+		CustomStandards:   viper.GetStringSlice("coding_standards"),
+		ProjectHints:      projectHints,
+		Tolerance:         tolerance,
+		Profile:           viper.GetString("profile"),
+		MaxPromptTokens:   viper.GetInt("max_prompt_tokens"),
+		IncludeRelated:    viper.GetBool("related_context"),
+		CommitMessage:     commitMessage,
+		SummarizeOver:     viper.GetInt("summarize_over_tokens"),
+		SuggestTests:      viper.GetBool("suggest_tests"),
+		Language:          viper.GetString("review_language"),
+		Analyzers:         viper.GetStringSlice("analyzers"),
+		Provider:          viper.GetString("provider"),
+		BaseURL:           viper.GetString("base_url"),
+		APIKey:            viper.GetString("api_key"),
+		LocalParallel:     viper.GetInt("local_max_parallel"),
+		LocalContext:      viper.GetInt("local_max_context"),
+		PersistentSession: viper.GetBool("persistent_session"),
+		RequestTimeout:    viper.GetDuration("request_timeout"),
+		RetryAttempts:     viper.GetInt("retry_attempts"),
+		RetryBackoff:      viper.GetDuration("retry_backoff"),
+		NoCache:           viper.GetBool("no_cache"),
+		CacheTTL:          viper.GetDuration("cache_ttl"),
+		MaxInFlight:       viper.GetInt("max_in_flight"),
+		RequestsPerMinute: viper.GetInt("requests_per_minute"),
+		ExtraCABundle:     viper.GetString("extra_ca_bundle"),
+		Providers:         viper.GetStringSlice("providers"),
+		QueueNotify:       queueReporter(),
+		Verbose:           viper.GetBool("verbose"),
+		Progress:          progressReporter(),
+		Display:           progressDisplay(),
+	})
 	if err != nil {
+		// The provider couldn't start; degrade to deterministic checks when
+		// configured instead of failing the commit outright
+		if viper.GetBool("offline_fallback") {
+			ui.Warning(fmt.Sprintf("Provider unavailable (%v)", err))
+			runOfflineReview(repoRoot, changes)
+			return
+		}
+		exitOnProviderError(err)
 		ui.Error(fmt.Sprintf("Failed to initialize reviewer: %v", err))
-		os.Exit(1)
+		ui.Info("Run 'prereview --offline' for a deterministic degraded review, or set offline_fallback: true to degrade automatically.")
+		os.Exit(exitToolFailure)
 	}
 	defer reviewer.Close()
 
-	// Run review
-	result, err := reviewer.Review(changes)
+	// Run review; Ctrl-C cancels the in-flight request and exits cleanly
+	ctx, stop := interruptibleContext()
+	defer stop()
+
+	// Fail fast on auth/subscription problems before any model requests
+	if err := reviewer.Warmup(ctx); err != nil {
+		exitOnProviderError(err)
+		ui.Error(fmt.Sprintf("Provider health check failed: %v", err))
+		os.Exit(exitToolFailure)
+	}
+
+	reviewStart := time.Now()
+	result, err := reviewer.Review(ctx, changes)
 	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			ui.Info("\nReview cancelled")
+			os.Exit(130)
+		}
+		exitOnProviderError(err)
 		ui.Error(fmt.Sprintf("Review failed: %v", err))
-		os.Exit(1)
+		os.Exit(exitToolFailure)
+	}
+
+	// Reviews of large commits take minutes and users switch away from the
+	// terminal, so long runs announce their results with a desktop
+	// notification (best effort - a missing notifier is not an error)
+	if after := viper.GetDuration("notify_after"); after > 0 && time.Since(reviewStart) >= after {
+		summary := fmt.Sprintf("Reviewed %d file(s): %d suggestion(s)", len(result.Files), len(result.Suggestions))
+		if err := ui.Notify("PreReview", summary); err != nil && viper.GetBool("verbose") {
+			ui.Muted(fmt.Sprintf("  Could not send desktop notification: %v", err))
+		}
+	}
+
+	// Token accounting: print the run's usage and fold it into the local
+	// ledger queryable via `prereview stats`
+	if summary := result.Usage.Summary(viper.GetString("model")); summary != "" {
+		ui.Muted("  " + summary)
+		if src.Name() == "git" {
+			if dir, err := git.PrereviewDir(); err == nil {
+				if err := review.AccumulateUsage(dir, result.Usage, viper.GetString("model")); err != nil && viper.GetBool("verbose") {
+					ui.Warning(fmt.Sprintf("Could not update usage ledger: %v", err))
+				}
+			}
+		}
+	}
+
+	// Compact statistics block: totals, breakdowns, and per-file cost
+	for _, line := range result.StatsBlock() {
+		ui.Muted("  " + line)
+	}
+
+	// Report memory stats for the run
+	if viper.GetBool("verbose") {
+		var m runtime.MemStats
+		runtime.ReadMemStats(&m)
+		ui.Muted(fmt.Sprintf("  Memory: %.1f MB in use, %.1f MB allocated over the run",
+			float64(m.HeapAlloc)/1e6, float64(m.TotalAlloc)/1e6))
+	}
+
+	// Write a baseline for future ratchet runs, before any filtering
+	if baselinePath := viper.GetString("save_baseline"); baselinePath != "" {
+		if err := review.SaveBaseline(baselinePath, result); err != nil {
+			ui.Error(fmt.Sprintf("Failed to save baseline: %v", err))
+			os.Exit(exitToolFailure)
+		}
+		ui.Success(fmt.Sprintf("✓ Saved baseline with %d finding(s) to %s", len(result.Suggestions), baselinePath))
+	}
+
+	// CI ratchet: only findings absent from the base branch's baseline
+	// count, so adoption doesn't require fixing every pre-existing issue
+	if baselinePath := viper.GetString("baseline"); baselinePath != "" {
+		known, err := review.LoadBaseline(baselinePath)
+		if err != nil {
+			ui.Error(fmt.Sprintf("Failed to load baseline: %v", err))
+			os.Exit(exitToolFailure)
+		}
+		fresh := review.NewFindings(result.Suggestions, known)
+		if suppressed := len(result.Suggestions) - len(fresh); suppressed > 0 {
+			ui.Muted(fmt.Sprintf("  %d known finding(s) suppressed by baseline", suppressed))
+		}
+		result.Suggestions = fresh
+	}
+
+	// Machine-readable output replaces the interactive flow entirely; it is
+	// emitted even for clean runs so consumers always get a document
+	if format := viper.GetString("format"); format != "" {
+		formatter, err := output.ForFormat(format)
+		if err != nil {
+			ui.Error(err.Error())
+			os.Exit(exitToolFailure)
+		}
+		rendered, err := formatter.Format(result)
+		if err != nil {
+			ui.Error(fmt.Sprintf("Failed to format result: %v", err))
+			os.Exit(exitToolFailure)
+		}
+		if path := viper.GetString("output_file"); path != "" {
+			if err := os.WriteFile(path, []byte(rendered), 0644); err != nil {
+				ui.Error(fmt.Sprintf("Failed to write %s: %v", path, err))
+				os.Exit(exitToolFailure)
+			}
+			ui.Success(fmt.Sprintf("✓ Wrote %s output to %s", formatter.Name(), path))
+		} else {
+			fmt.Print(rendered)
+		}
+		if src.Name() == "git" {
+			if dir, err := git.PrereviewDir(); err == nil {
+				_ = review.AppendHistory(dir, review.NewHistoryRecord(result, 0, 0))
+			}
+		}
+		applyFailOn(result.Suggestions)
+		return
 	}
 
 	if len(result.Suggestions) == 0 {
@@ -109,22 +336,54 @@ func runReview(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	// Generate markdown file if enabled (do this before hook mode exit)
+	// Persist the result so `prereview apply --suggestion <id>` can
+	// operate on it later without re-reviewing
+	if src.Name() == "git" {
+		if dir, err := git.PrereviewDir(); err == nil {
+			if err := review.SaveResult(dir, result); err != nil && viper.GetBool("verbose") {
+				ui.Warning(fmt.Sprintf("Could not save review result: %v", err))
+			}
+		}
+	}
+
+	// Generate markdown file if enabled (do this before hook mode exit).
+	// --output pins a stable filename; the default embeds the commit hash.
 	if viper.GetBool("output_markdown") {
 		generator := output.NewMarkdownGenerator(repoRoot)
-		filePath, err := generator.GenerateSuggestionsFile(result)
+		var filePath string
+		var err error
+		if dest := viper.GetString("output_file"); dest != "" {
+			filePath, err = generator.GenerateSuggestionsFileAt(result, dest)
+		} else {
+			filePath, err = generator.GenerateSuggestionsFile(result)
+		}
 		if err != nil {
 			ui.Error(fmt.Sprintf("Failed to generate markdown file: %v", err))
-			os.Exit(1)
+			os.Exit(exitToolFailure)
 		}
 		ui.Success(fmt.Sprintf("✓ Generated suggestions file: %s", filePath))
 	}
 
-	// Check if running in hook mode (non-interactive)
-	if viper.GetBool("hook") {
+	// Record a compact history entry for digests and stats. The interactive
+	// path records after the session instead, so fix counts are captured.
+	recordHistory := func(fixed, skipped int) {
+		if src.Name() != "git" {
+			return
+		}
+		if dir, err := git.PrereviewDir(); err == nil {
+			_ = review.AppendHistory(dir, review.NewHistoryRecord(result, fixed, skipped))
+		}
+	}
+
+	// Check if running in hook mode (non-interactive). A configured web
+	// session takes precedence: it exists precisely for hooks launched by
+	// GUI git clients, which have no terminal to block on.
+	if viper.GetBool("hook") && !viper.GetBool("web") {
+		recordHistory(0, 0)
 		// Check for force flag first
 		if viper.GetBool("force") {
 			ui.Info(fmt.Sprintf("Found %d suggestion(s) - force flag set, proceeding with commit", len(result.Suggestions)))
+			printHookSummary(result)
 			return
 		}
 
@@ -133,16 +392,26 @@ func runReview(cmd *cobra.Command, args []string) {
 		warnings := 0
 		infos := 0
 		lowConfidence := 0
-		
+
 		blockOn := viper.GetString("block_on") // errors, warnings, all, none
-		
+
+		// --fail-on is the flag-level equivalent of block_on
+		switch viper.GetString("fail_on") {
+		case "error":
+			blockOn = "errors"
+		case "warning":
+			blockOn = "warnings"
+		case "any":
+			blockOn = "all"
+		}
+
 		for _, s := range result.Suggestions {
 			// Low confidence suggestions never block
 			if s.Confidence == "low" {
 				lowConfidence++
 				continue
 			}
-			
+
 			switch s.Severity {
 			case "error":
 				// Only high confidence errors block by default
@@ -158,8 +427,8 @@ func runReview(cmd *cobra.Command, args []string) {
 			}
 		}
 
-		ui.Info(fmt.Sprintf("Found %d suggestion(s) across %d file(s)", len(result.Suggestions), len(result.Files)))
-		
+		ui.Summary(fmt.Sprintf("Found %d suggestion(s) across %d file(s)", len(result.Suggestions), len(result.Files)))
+
 		if blockingErrors > 0 {
 			ui.Error(fmt.Sprintf("  🔴 %d blocking error(s)", blockingErrors))
 		}
@@ -186,28 +455,53 @@ func runReview(cmd *cobra.Command, args []string) {
 			shouldBlock = blockingErrors > 0
 		}
 
+		// A custom gate expression takes precedence over block_on
+		if failIf := viper.GetString("fail_if"); failIf != "" {
+			blocked, err := gate.Eval(failIf, result.Suggestions)
+			if err != nil {
+				ui.Warning(fmt.Sprintf("Invalid fail_if expression: %v (falling back to block_on)", err))
+			} else {
+				shouldBlock = blocked
+				if blocked {
+					ui.Warning(fmt.Sprintf("Gate expression matched: %s", failIf))
+				}
+			}
+		}
+
 		// In strict mode, always block if there are any high-confidence issues
 		if viper.GetBool("strict") && shouldBlock {
 			ui.Warning("\nStrict mode: commit blocked due to high-confidence issues")
 			ui.Info("Run 'prereview' interactively to review and fix issues.")
 			ui.Info("Or use 'git commit --no-verify' or 'prereview --force' to bypass.")
-			os.Exit(1)
+			printHookSummary(result)
+			os.Exit(findingsExitCode(result.Suggestions))
+		}
+
+		// Non-strict mode: block only on blocking errors, but allow override.
+		// Without a terminal there is nobody to ask, so block outright
+		// instead of hanging on stdin.
+		if shouldBlock && !ui.IsInteractive() {
+			ui.Info("Commit blocked. Run 'prereview' interactively to review and fix issues.")
+			ui.Info("Or use 'git commit --no-verify' or 'prereview --force' to bypass.")
+			printHookSummary(result)
+			os.Exit(findingsExitCode(result.Suggestions))
 		}
 
-		// Non-strict mode: block only on blocking errors, but allow override
 		if shouldBlock {
 			fmt.Print("\nProceed with commit despite issues? [y/N]: ")
 			var response string
 			fmt.Scanln(&response)
-			
+
 			response = strings.ToLower(strings.TrimSpace(response))
 			if response == "y" || response == "yes" {
 				ui.Success("✓ Proceeding with commit...")
+				printHookSummary(result)
 				return
 			}
-			
+
 			ui.Info("Commit aborted. Run 'prereview' interactively to review and fix issues.")
-			os.Exit(1)
+			printHookSummary(result)
+			os.Exit(findingsExitCode(result.Suggestions))
 		}
 
 		// No blocking issues
@@ -215,20 +509,86 @@ func runReview(cmd *cobra.Command, args []string) {
 			ui.Info("\nNo blocking issues found. Proceeding with commit...")
 			ui.Muted("(Run 'prereview' to review non-blocking suggestions)")
 		}
+		printHookSummary(result)
+		return
+	}
+
+	// Scripted auto-fix: apply qualifying fixes without prompting, stage
+	// them, and print a patch summary instead of opening a session
+	if threshold := viper.GetString("auto_fix"); threshold != "" {
+		runAutoFix(result, threshold, recordHistory)
 		return
 	}
 
 	// Check if markdown output is enabled (non-hook mode, return after generating)
 	if viper.GetBool("output_markdown") {
+		recordHistory(0, 0)
 		ui.Info(fmt.Sprintf("  Found %d suggestion(s) across %d file(s)", len(result.Suggestions), len(result.Files)))
+		applyFailOn(result.Suggestions)
+		return
+	}
+
+	session := ui.NewReviewSession(result, viper.GetBool("verbose"))
+
+	// GUI git clients run hooks without a terminal; --web serves the
+	// session over a local HTTP server instead of falling back to the
+	// plain report
+	if viper.GetBool("web") {
+		if src.Name() == "git" {
+			if dir, err := git.PrereviewDir(); err == nil {
+				session.SetStateDir(dir)
+			}
+		}
+		handleSessionOutcome(cmd, args, session.RunWeb(viper.GetString("web_addr")), recordHistory)
 		return
 	}
 
-	// Interactive review session
-	session := ui.NewReviewSession(result)
-	outcome := session.Run()
+	// Fall back to a plain report when interactivity is disabled or no
+	// terminal is attached (piped output, CI, IDE tasks)
+	if !viper.GetBool("interactive") || !ui.IsInteractive() || viper.GetBool("quiet") {
+		recordHistory(0, 0)
+		if !viper.GetBool("quiet") {
+			session.Report()
+		}
+		ui.Summary(fmt.Sprintf("\nFound %d suggestion(s) across %d file(s). Run 'prereview' in a terminal to triage interactively.", len(result.Suggestions), len(result.Files)))
+		applyFailOn(result.Suggestions)
+		return
+	}
 
-	// Handle outcome
+	// Interactive review session; e(xplain) asks the reviewer follow-ups
+	session.SetExplainer(reviewer.Explain)
+	session.SetEditorCommand(viper.GetString("editor_command"))
+	session.SetKeybindings(viper.GetStringMapString("keybindings"))
+	if viper.GetBool("verify_fixes") {
+		session.SetVerifier(reviewer.VerifyFix)
+	}
+	// Persist progress so an interrupted session can be picked up with
+	// `prereview resume`
+	if src.Name() == "git" {
+		if dir, err := git.PrereviewDir(); err == nil {
+			session.SetStateDir(dir)
+			// +/- votes feed the feedback log that tunes future reviews
+			session.SetFeedbackSink(func(sug review.Suggestion, useful bool) {
+				_ = review.AppendFeedback(dir, review.NewFeedbackRecord(sug, useful))
+			})
+		}
+	}
+	// --save-session mirrors the session to a standalone file that
+	// `prereview resume <file>` can pick up, even on another checkout
+	if path := viper.GetString("save_session"); path != "" {
+		session.SetExportPath(path)
+	}
+	handleSessionOutcome(cmd, args, session.Run(), recordHistory)
+}
+
+// handleSessionOutcome acts on how a session (terminal or web) ended:
+// record it in history, enforce strict mode, or kick off a targeted
+// re-review of the files that applied fixes touched
+func handleSessionOutcome(cmd *cobra.Command, args []string, outcome ui.SessionOutcome, recordHistory func(fixed, skipped int)) {
+	// A re-review records its own run instead
+	if outcome.Action != ui.ActionReReview {
+		recordHistory(outcome.Fixed, outcome.Skipped)
+	}
 	switch outcome.Action {
 	case ui.ActionCommit:
 		ui.Success(fmt.Sprintf("\n✓ Review complete: %d fixed, %d skipped", outcome.Fixed, outcome.Skipped))
@@ -240,11 +600,292 @@ func runReview(cmd *cobra.Command, args []string) {
 		ui.Info("\n✗ Review aborted")
 		os.Exit(1)
 	case ui.ActionReReview:
-		ui.Info("\n🔄 Re-reviewing changes...")
+		// Fixes only touched specific files - re-review just those
+		if len(outcome.ModifiedFiles) > 0 {
+			reReviewFiles = make(map[string]bool, len(outcome.ModifiedFiles))
+			for _, path := range outcome.ModifiedFiles {
+				reReviewFiles[path] = true
+			}
+			ui.Info(fmt.Sprintf("\n🔄 Re-reviewing %d fixed file(s)...", len(outcome.ModifiedFiles)))
+		} else {
+			ui.Info("\n🔄 Re-reviewing changes...")
+		}
 		runReview(cmd, args)
 	}
 }
 
+// progressReporter returns the per-file progress callback for this run, or
+// nil when progress reporting is disabled. Long CI and markdown runs would
+// otherwise sit silent for minutes, which some CI systems kill as hung.
+func progressReporter() review.ProgressFunc {
+	mode := viper.GetString("progress")
+	if mode == "" {
+		// Default: report in non-interactive runs, stay quiet otherwise
+		// (the interactive path already prints per-file lines)
+		if viper.GetBool("hook") || viper.GetBool("output_markdown") || !ui.IsInteractive() {
+			mode = "plain"
+		} else {
+			return nil
+		}
+	}
+
+	switch mode {
+	case "plain":
+		return func(ev review.ProgressEvent) {
+			line := fmt.Sprintf("prereview: %d/%d file(s) reviewed", ev.Done, ev.Total)
+			if ev.ETA > 0 {
+				line += fmt.Sprintf(", ETA %s", ev.ETA.Round(time.Second))
+			}
+			fmt.Fprintln(os.Stderr, line)
+		}
+	case "json":
+		return func(ev review.ProgressEvent) {
+			event := struct {
+				Event          string  `json:"event"`
+				Done           int     `json:"done"`
+				Total          int     `json:"total"`
+				Path           string  `json:"path"`
+				ElapsedSeconds float64 `json:"elapsed_seconds"`
+				ETASeconds     float64 `json:"eta_seconds"`
+			}{"file_reviewed", ev.Done, ev.Total, ev.Path, ev.Elapsed.Seconds(), ev.ETA.Seconds()}
+			if data, err := json.Marshal(event); err == nil {
+				fmt.Fprintln(os.Stderr, string(data))
+			}
+		}
+	default: // "off", "none"
+		return nil
+	}
+}
+
+// runOfflineReview runs the degraded offline review path: secret scanning,
+// deterministic per-language rules, and configured analyzers, followed by a
+// manual checklist covering what the AI would normally catch. Only hard
+// findings (secrets) block the commit in hook mode.
+func runOfflineReview(repoRoot string, changes []git.FileChange) {
+	ui.Info(fmt.Sprintf("🔌 Offline mode: running deterministic checks on %d file(s)...\n", len(changes)))
+
+	reviewer, err := review.NewPromptPreviewer(review.Options{
+		RepoRoot:        repoRoot,
+		CustomStandards: viper.GetStringSlice("coding_standards"),
+		Analyzers:       viper.GetStringSlice("analyzers"),
+	})
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to initialize offline review: %v", err))
+		os.Exit(exitToolFailure)
+	}
+
+	result, err := reviewer.ReviewOffline(changes)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Offline review failed: %v", err))
+		os.Exit(exitToolFailure)
+	}
+
+	if len(result.Suggestions) > 0 {
+		ui.NewReviewSession(result, viper.GetBool("verbose")).Report()
+	} else {
+		ui.Success("✓ No deterministic findings")
+	}
+
+	ui.Info("\n📋 Manual review checklist (no AI review was performed):")
+	for _, item := range reviewer.OfflineChecklist() {
+		fmt.Printf("  ☐ %s\n", item)
+	}
+
+	blocking := 0
+	for _, s := range result.Suggestions {
+		if s.Severity == review.SeverityError {
+			blocking++
+		}
+	}
+	if viper.GetBool("hook") && blocking > 0 && !viper.GetBool("force") {
+		ui.Error(fmt.Sprintf("\n%d blocking finding(s) - commit blocked (use --force to bypass)", blocking))
+		os.Exit(exitFindingsError)
+	}
+}
+
+// Exit codes. Findings map to 1 (errors present) or 2 (warnings only);
+// tool and provider failures sit above 10 so CI can tell a policy failure
+// from breakage without parsing output.
+const (
+	exitFindingsError   = 1
+	exitFindingsWarning = 2
+
+	exitToolFailure      = 11
+	exitNotAuthenticated = 13
+	exitRateLimited      = 14
+	exitModelUnavailable = 15
+	exitContextTooLarge  = 16
+)
+
+// findingsExitCode picks the severity-aware exit code for a blocked run
+func findingsExitCode(suggestions []review.Suggestion) int {
+	for _, s := range suggestions {
+		if s.Severity == review.SeverityError {
+			return exitFindingsError
+		}
+	}
+	return exitFindingsWarning
+}
+
+// progressDisplay picks how per-file progress is rendered: the spinner and
+// progress bar on an interactive terminal, plain lines (the reviewer's
+// default) everywhere else so logs and piped output stay clean
+func progressDisplay() review.ProgressDisplay {
+	if ui.IsInteractive() && !ui.IsAccessible() && !viper.GetBool("quiet") && viper.GetString("format") == "" {
+		return ui.NewReviewProgress()
+	}
+	return nil
+}
+
+// runAutoFix applies every fix at or above the given confidence without
+// prompting, stages the changes, and prints a patch summary - for scripted
+// cleanups and CI bots that push fixup commits
+func runAutoFix(result *review.ReviewResult, threshold string, recordHistory func(fixed, skipped int)) {
+	rank := map[string]int{"high": 0, "medium": 1, "low": 2}
+	limit, ok := rank[threshold]
+	if !ok {
+		ui.Warning(fmt.Sprintf("Unknown --auto-fix confidence %q, applying high-confidence fixes only", threshold))
+		limit = 0
+	}
+
+	session := ui.NewReviewSession(result, viper.GetBool("verbose"))
+	applied, skipped := 0, 0
+	perFile := make(map[string]int)
+	for _, sug := range result.Suggestions {
+		// Unreported confidence is treated as high, matching hook mode
+		confidence, known := rank[string(sug.Confidence)]
+		if !known {
+			confidence = 0
+		}
+		if confidence > limit {
+			skipped++
+			continue
+		}
+		if session.Apply(sug) {
+			applied++
+			perFile[sug.File]++
+		} else {
+			skipped++
+		}
+	}
+	recordHistory(applied, skipped)
+
+	ui.Summary(fmt.Sprintf("Auto-fix applied %d of %d suggestion(s)", applied, len(result.Suggestions)))
+	for _, file := range result.Files {
+		if n := perFile[file]; n > 0 {
+			ui.Info(fmt.Sprintf("  %s: %d fix(es)", file, n))
+		}
+	}
+	if skipped > 0 {
+		ui.Muted(fmt.Sprintf("  %d suggestion(s) left for manual review", skipped))
+	}
+
+	// Patch summary of what is now staged
+	if applied > 0 {
+		if out, err := exec.Command("git", "diff", "--cached", "--stat").Output(); err == nil && len(out) > 0 {
+			fmt.Println()
+			fmt.Print(string(out))
+		}
+	}
+}
+
+// applyFailOn enforces the --fail-on policy on non-hook runs (formatted
+// output, plain reports) after the result has been emitted. Hook mode
+// folds the same policy into its block_on handling instead.
+func applyFailOn(suggestions []review.Suggestion) {
+	failOn := viper.GetString("fail_on")
+	if failOn == "" || len(suggestions) == 0 {
+		return
+	}
+
+	errorCount := 0
+	warningCount := 0
+	for _, s := range suggestions {
+		switch s.Severity {
+		case review.SeverityError:
+			errorCount++
+		case review.SeverityWarning:
+			warningCount++
+		}
+	}
+
+	switch failOn {
+	case "error":
+		if errorCount > 0 {
+			os.Exit(exitFindingsError)
+		}
+	case "warning":
+		if errorCount > 0 {
+			os.Exit(exitFindingsError)
+		}
+		if warningCount > 0 {
+			os.Exit(exitFindingsWarning)
+		}
+	case "any":
+		os.Exit(findingsExitCode(suggestions))
+	default:
+		ui.Warning(fmt.Sprintf("Unknown fail_on value %q (expected error, warning, or any)", failOn))
+	}
+}
+
+// exitOnProviderError prints targeted remediation and exits with a
+// distinct code when err is one of the typed provider errors; unknown
+// errors fall through to the caller's generic handling
+func exitOnProviderError(err error) {
+	switch {
+	case errors.Is(err, llm.ErrNotAuthenticated):
+		ui.Error(fmt.Sprintf("Provider authentication failed: %v", err))
+		ui.Info("Run 'copilot auth login' (or set api_key for HTTP providers) and try again.")
+		os.Exit(exitNotAuthenticated)
+	case errors.Is(err, llm.ErrRateLimited):
+		ui.Error("Provider rate limit exceeded")
+		ui.Info("Wait a minute and retry, or set requests_per_minute to pace requests.")
+		os.Exit(exitRateLimited)
+	case errors.Is(err, llm.ErrModelUnavailable):
+		ui.Error(fmt.Sprintf("Model unavailable: %v", err))
+		ui.Info("Run 'prereview models' to list the models available to you.")
+		os.Exit(exitModelUnavailable)
+	case errors.Is(err, llm.ErrContextTooLarge):
+		ui.Error("Prompt exceeded the model's context window")
+		ui.Info("Lower max_prompt_tokens or summarize_over_tokens to shrink prompts.")
+		os.Exit(exitContextTooLarge)
+	}
+}
+
+// queueReporter returns the callback invoked when a request queues behind
+// the provider rate limiter, or nil when no limits are configured, so users
+// see why the review pauses instead of a silent stall
+func queueReporter() func(queued int) {
+	if viper.GetInt("max_in_flight") == 0 && viper.GetInt("requests_per_minute") == 0 {
+		return nil
+	}
+	return func(queued int) {
+		fmt.Fprintf(os.Stderr, "prereview: rate limit reached, %d request(s) queued\n", queued)
+	}
+}
+
+// printHookSummary emits a single machine-parsable verdict line on stderr
+// as the last hook-mode output, so the essential result survives even when
+// git truncates or reorders hook output
+func printHookSummary(result *review.ReviewResult) {
+	errors := 0
+	warnings := 0
+	autoFixable := 0
+	for _, s := range result.Suggestions {
+		switch s.Severity {
+		case review.SeverityError:
+			errors++
+		case review.SeverityWarning:
+			warnings++
+		}
+		if s.SuggestFix != "" && s.SuggestFix != "N/A" && !s.Unfixable {
+			autoFixable++
+		}
+	}
+	fmt.Fprintf(os.Stderr, "prereview: %d error(s), %d warning(s), %d auto-fixable — run 'prereview' to triage\n",
+		errors, warnings, autoFixable)
+}
+
 // shouldIgnoreFile checks if a file path matches any ignore patterns
 func shouldIgnoreFile(filePath string, patterns []string) bool {
 	// Normalize path separators
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/emilushi/prereview/internal/ui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -46,14 +47,28 @@ func init() {
 	rootCmd.PersistentFlags().Bool("verbose", false, "Show detailed output")
 	rootCmd.PersistentFlags().Bool("hook", false, "Run in pre-commit hook mode (non-interactive, exits with error if issues found)")
 	rootCmd.PersistentFlags().String("tolerance", "", "Review tolerance level: strict, moderate, relaxed (default: moderate)")
+	rootCmd.PersistentFlags().String("profile", "", "Review profile: security (specialized OWASP-focused review)")
+	rootCmd.PersistentFlags().Bool("interactive", true, "Run the interactive session (auto-disabled when not attached to a terminal)")
 	rootCmd.PersistentFlags().Bool("force", false, "Force commit even with unresolved suggestions (bypasses blocking)")
+	rootCmd.PersistentFlags().StringP("message", "m", "", "Intended commit message, used as intent context for the review")
+	rootCmd.PersistentFlags().Bool("no-cache", false, "Bypass the on-disk response cache for this run")
+	rootCmd.PersistentFlags().Bool("offline", false, "Degraded mode: run only deterministic checks, no AI provider")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Print only summary lines, warnings, and errors")
+	rootCmd.PersistentFlags().Bool("no-color", false, "Disable colored output (also honored via NO_COLOR or CLICOLOR=0)")
 
 	_ = viper.BindPFlag("model", rootCmd.PersistentFlags().Lookup("model"))
 	_ = viper.BindPFlag("strict", rootCmd.PersistentFlags().Lookup("strict"))
 	_ = viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	_ = viper.BindPFlag("hook", rootCmd.PersistentFlags().Lookup("hook"))
 	_ = viper.BindPFlag("tolerance", rootCmd.PersistentFlags().Lookup("tolerance"))
+	_ = viper.BindPFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
+	_ = viper.BindPFlag("interactive", rootCmd.PersistentFlags().Lookup("interactive"))
 	_ = viper.BindPFlag("force", rootCmd.PersistentFlags().Lookup("force"))
+	_ = viper.BindPFlag("message", rootCmd.PersistentFlags().Lookup("message"))
+	_ = viper.BindPFlag("no_cache", rootCmd.PersistentFlags().Lookup("no-cache"))
+	_ = viper.BindPFlag("offline", rootCmd.PersistentFlags().Lookup("offline"))
+	_ = viper.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet"))
+	_ = viper.BindPFlag("no_color", rootCmd.PersistentFlags().Lookup("no-color"))
 }
 
 func initConfig() {
@@ -73,14 +88,59 @@ func initConfig() {
 
 	// Set defaults
 	viper.SetDefault("model", "gpt-4o-mini")
+	viper.SetDefault("provider", "copilot")       // Chat backend: copilot, openai, ollama, anthropic, gemini
+	viper.SetDefault("base_url", "")              // OpenAI-compatible endpoint base URL
+	viper.SetDefault("api_key", "")               // API key for HTTP providers (or OPENAI_API_KEY)
+	viper.SetDefault("local_max_parallel", 1)     // Local providers: max concurrent generations
+	viper.SetDefault("local_max_context", 0)      // Local providers: prompt budget in estimated tokens, 0 = unlimited
+	viper.SetDefault("persistent_session", false) // Copilot: reuse one session across files instead of one per file
+	viper.SetDefault("request_timeout", "5m")     // Per-request timeout; "0" disables
+	viper.SetDefault("retry_attempts", 2)         // Retries after transient provider errors (rate limits, transport)
+	viper.SetDefault("retry_backoff", "2s")       // Initial retry delay, doubled per retry
+	viper.SetDefault("max_in_flight", 0)          // Max concurrent provider requests, 0 = unlimited
+	viper.SetDefault("requests_per_minute", 0)    // Provider requests per rolling minute, 0 = unlimited
+	viper.SetDefault("extra_ca_bundle", "")       // Extra PEM roots for TLS-intercepting corporate proxies
+	viper.SetDefault("providers", []string{})     // Ordered failover list; overrides provider when set
+	viper.SetDefault("format", "")                // Machine-readable output format: json
+	viper.SetDefault("output_file", "")           // Destination for formatted output, "" = stdout
 	viper.SetDefault("strict", false)
 	viper.SetDefault("verbose", false)
-	viper.SetDefault("tolerance", "moderate")    // strict, moderate, relaxed
+	viper.SetDefault("tolerance", "moderate") // strict, moderate, relaxed
+	viper.SetDefault("profile", "")           // Review profile: security
 	viper.SetDefault("ignore_patterns", []string{})
-	viper.SetDefault("max_file_size", 100000)    // 100KB
-	viper.SetDefault("block_on", "errors")       // errors, warnings, all, none
-	viper.SetDefault("coding_standards", []string{}) // Additional standard files to detect
-	viper.SetDefault("project_hints", []string{})    // Project-specific hints for the AI
+	viper.SetDefault("max_file_size", 100000)            // 100KB
+	viper.SetDefault("max_prompt_tokens", 0)             // Prompt token budget, 0 = unlimited
+	viper.SetDefault("related_context", true)            // Include related-file signatures in prompts
+	viper.SetDefault("summarize_over_tokens", 60000)     // Shallow summarized pass above this estimated size, 0 = never
+	viper.SetDefault("suggest_tests", false)             // Nudge about new functions without test changes
+	viper.SetDefault("verify_fixes", false)              // Sanity-check each applied fix with the model before staging
+	viper.SetDefault("review_language", "")              // Language for titles/descriptions (ja, de, es, ...), "" = English
+	viper.SetDefault("analyzers", []string{})            // Static analyzers to run as a pre-pass (eslint, golangci-lint, phpcs)
+	viper.SetDefault("block_on", "errors")               // errors, warnings, all, none
+	viper.SetDefault("fail_if", "")                      // Gate expression, e.g. "count(severity=='error') > 0"
+	viper.SetDefault("fail_on", "")                      // Severity threshold for non-zero exits: error, warning, any
+	viper.SetDefault("save_session", "")                 // Mirror interactive sessions to this file
+	viper.SetDefault("source", "git")                    // Change source: git, svn, snapshot
+	viper.SetDefault("source_dir", "")                   // Directory for the snapshot source
+	viper.SetDefault("coding_standards", []string{})     // Additional standard files to detect
+	viper.SetDefault("project_hints", []string{})        // Project-specific hints for the AI
+	viper.SetDefault("no_cache", false)                  // Bypass the on-disk response cache
+	viper.SetDefault("offline", false)                   // Degraded mode: deterministic checks only
+	viper.SetDefault("quiet", false)                     // Print only summary lines, warnings, and errors
+	viper.SetDefault("editor_command", "")               // o(pen) template, e.g. "code -g {file}:{line}"; "" = $EDITOR
+	viper.SetDefault("keybindings", map[string]string{}) // Session key remaps, action name -> key
+	viper.SetDefault("auto_fix", "")                     // Apply fixes at/above this confidence without prompting, "" = off
+	viper.SetDefault("no_color", false)                  // Disable colored output
+	viper.SetDefault("web", false)                       // Serve the session over HTTP instead of the terminal
+	viper.SetDefault("web_addr", "")                     // Listen address for --web, "" = 127.0.0.1 with a random port
+	viper.SetDefault("notify_after", "2m")               // Desktop notification for reviews longer than this, "0" = never
+	viper.SetDefault("theme", "auto")                    // Color palette: auto, dark, light
+	viper.SetDefault("ui.ascii", false)                  // ASCII-only glyphs for consoles without Unicode support
+	viper.SetDefault("ui.accessible", false)             // Screen-reader friendly output: level prefixes, no redraws
+	viper.SetDefault("offline_fallback", false)          // Degrade automatically when the provider can't start
+	viper.SetDefault("cache_ttl", "168h")                // How long cached responses are kept
+	viper.SetDefault("history_retention_days", 90)       // How long history, transcripts, and reports are kept
+	viper.SetDefault("digest_repos", []string{})         // Additional repository paths to aggregate in `prereview digest`
 
 	viper.AutomaticEnv()
 
@@ -94,4 +154,20 @@ func initConfig() {
 			fmt.Fprintf(os.Stderr, "Error reading config file: %v\n", err)
 		}
 	}
+
+	// Quiet mode gates informational output at the UI layer
+	ui.SetQuiet(viper.GetBool("quiet"))
+
+	// Color handling: pick the palette for the terminal background, and
+	// strip styling entirely when asked to
+	ui.SetTheme(viper.GetString("theme"))
+	if viper.GetBool("no_color") {
+		ui.SetPlain(true)
+	}
+	if viper.GetBool("ui.ascii") {
+		ui.SetASCII(true)
+	}
+	if viper.GetBool("ui.accessible") {
+		ui.SetAccessible(true)
+	}
 }
@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// interruptibleContext returns a context cancelled by Ctrl-C or SIGTERM, so
+// in-flight provider requests are aborted and sessions cleaned up instead
+// of the process hanging on a stuck response
+func interruptibleContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show accumulated token usage for this repository",
+	Long: `Show the token usage and estimated cost accumulated across all
+review runs in this repository.
+
+Each review adds its request count and token totals to a local ledger
+(stored under .git/prereview), so teams can see what their reviews cost.`,
+	Run: runStats,
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(cmd *cobra.Command, args []string) {
+	dir, err := git.PrereviewDir()
+	if err != nil {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	ledger, err := review.LoadUsageLedger(dir)
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to load usage ledger: %v", err))
+		os.Exit(1)
+	}
+	if ledger != nil && ledger.Runs > 0 {
+		ui.Info(fmt.Sprintf("📊 Usage since %s\n", ledger.Since.Format("2006-01-02")))
+		fmt.Printf("  Review runs:       %d\n", ledger.Runs)
+		fmt.Printf("  Requests:          %d\n", ledger.Requests)
+		fmt.Printf("  Prompt tokens:     %d\n", ledger.PromptTokens)
+		fmt.Printf("  Completion tokens: %d\n", ledger.CompletionTokens)
+		if ledger.CostUSD > 0 {
+			fmt.Printf("  Estimated cost:    ~$%.2f\n", ledger.CostUSD)
+		}
+		if ledger.Estimated {
+			ui.Muted("\n  Some token counts were estimated (provider did not report usage)")
+		}
+	}
+
+	printOutcomeStats(dir, ledger == nil || ledger.Runs == 0)
+}
+
+// printOutcomeStats summarizes review outcomes from the history database:
+// how many findings were fixed versus skipped, and which categories recur
+func printOutcomeStats(dir string, noUsage bool) {
+	records, err := review.LoadHistory(dir, time.Time{})
+	if err != nil || len(records) == 0 {
+		if noUsage {
+			ui.Info("No usage recorded yet. Run a review first.")
+		}
+		return
+	}
+
+	findings, fixed, skipped := 0, 0, 0
+	categories := make(map[string]int)
+	for _, record := range records {
+		findings += len(record.Findings)
+		fixed += record.Fixed
+		skipped += record.Skipped
+		for _, f := range record.Findings {
+			if f.Category != "" {
+				categories[f.Category]++
+			}
+		}
+	}
+
+	fmt.Println()
+	ui.Info("📈 Review outcomes\n")
+	fmt.Printf("  Runs:              %d\n", len(records))
+	fmt.Printf("  Findings:          %d\n", findings)
+	if fixed+skipped > 0 {
+		fmt.Printf("  Fixed vs skipped:  %d / %d (%.0f%% fixed)\n",
+			fixed, skipped, 100*float64(fixed)/float64(fixed+skipped))
+	}
+
+	if len(categories) > 0 {
+		names := make([]string, 0, len(categories))
+		for name := range categories {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if categories[names[i]] != categories[names[j]] {
+				return categories[names[i]] > categories[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		if len(names) > 5 {
+			names = names[:5]
+		}
+		fmt.Println("  Top categories:")
+		for _, name := range names {
+			fmt.Printf("    %-18s %d\n", name, categories[name])
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/review"
+	"github.com/emilushi/prereview/internal/source"
+	"github.com/emilushi/prereview/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var summarizeCmd = &cobra.Command{
+	Use:   "summarize",
+	Short: "Generate a PR-description summary of the staged changes",
+	Long: `Generate a concise, PR-description-ready markdown summary of the
+staged changes: what changed, risk areas, and where reviewers should
+focus.
+
+This is prose for a pull request body, separate from finding-level
+review output:
+  prereview summarize -o pr-description.md`,
+	Run: runSummarize,
+}
+
+func init() {
+	rootCmd.AddCommand(summarizeCmd)
+	summarizeCmd.Flags().StringP("output", "o", "", "Write the summary to a file instead of stdout")
+}
+
+func runSummarize(cmd *cobra.Command, args []string) {
+	src, err := source.ForName(viper.GetString("source"), viper.GetString("source_dir"))
+	if err != nil {
+		ui.Error(err.Error())
+		os.Exit(1)
+	}
+	if !src.Available() {
+		ui.Error("Not a git repository")
+		os.Exit(1)
+	}
+
+	repoRoot, err := src.Root()
+	if err != nil {
+		repoRoot = "."
+	}
+
+	changes, err := src.Changes()
+	if err != nil {
+		ui.Error(fmt.Sprintf("Failed to get changes: %v", err))
+		os.Exit(1)
+	}
+	if len(changes) == 0 {
+		ui.Info("No staged changes to summarize")
+		return
+	}
+
+	// Intent context, same precedence as the review command
+	commitMessage := viper.GetString("message")
+	if commitMessage == "" && src.Name() == "git" {
+		commitMessage = git.ReadPreparedCommitMessage()
+	}
+
+	ui.Info(fmt.Sprintf("📝 Summarizing %d changed file(s)...\n", len(changes)))
+
+	reviewer, err := review.NewReviewer(review.Options{
+		Model:             viper.GetString("model"),
+		RepoRoot:          repoRoot,
+		CommitMessage:     commitMessage,
+		Provider:          viper.GetString("provider"),
+		BaseURL:           viper.GetString("base_url"),
+		APIKey:            viper.GetString("api_key"),
+		PersistentSession: viper.GetBool("persistent_session"),
+		RequestTimeout:    viper.GetDuration("request_timeout"),
+		RetryAttempts:     viper.GetInt("retry_attempts"),
+		RetryBackoff:      viper.GetDuration("retry_backoff"),
+		NoCache:           viper.GetBool("no_cache"),
+		CacheTTL:          viper.GetDuration("cache_ttl"),
+		ExtraCABundle:     viper.GetString("extra_ca_bundle"),
+		Providers:         viper.GetStringSlice("providers"),
+	})
+	if err != nil {
+		exitOnProviderError(err)
+		ui.Error(fmt.Sprintf("Failed to initialize reviewer: %v", err))
+		os.Exit(1)
+	}
+	defer reviewer.Close()
+
+	ctx, stop := interruptibleContext()
+	defer stop()
+
+	summary, err := reviewer.SummarizeChanges(ctx, changes)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			ui.Info("\nSummary cancelled")
+			os.Exit(1)
+		}
+		exitOnProviderError(err)
+		ui.Error(fmt.Sprintf("Summary failed: %v", err))
+		os.Exit(1)
+	}
+
+	if path, _ := cmd.Flags().GetString("output"); path != "" {
+		if err := os.WriteFile(path, []byte(summary+"\n"), 0644); err != nil {
+			ui.Error(fmt.Sprintf("Failed to write %s: %v", path, err))
+			os.Exit(1)
+		}
+		ui.Success(fmt.Sprintf("✓ Wrote summary to %s", path))
+		return
+	}
+
+	fmt.Println(summary)
+}
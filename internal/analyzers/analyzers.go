@@ -0,0 +1,204 @@
+package analyzers
+
+import (
+	"encoding/json"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Finding is a single issue reported by a static analyzer
+type Finding struct {
+	Tool     string // Which analyzer reported it
+	Line     int    // 1-based line number
+	Severity string // error, warning, info
+	Rule     string // Tool-specific rule identifier (e.g., no-unused-vars)
+	Message  string
+}
+
+// analyzer describes how to invoke one supported linter and parse its output
+type analyzer struct {
+	name       string
+	binary     string
+	extensions []string
+	args       func(path string) []string
+	parse      func(output []byte, path string) []Finding
+}
+
+// supported are the linters prereview knows how to drive. Enable them via
+// the `analyzers` config list; analyzers whose binary is not installed are
+// skipped silently.
+var supported = []analyzer{
+	{
+		name:       "eslint",
+		binary:     "eslint",
+		extensions: []string{".js", ".jsx", ".ts", ".tsx", ".mjs"},
+		args: func(path string) []string {
+			return []string{"--format", "json", "--no-color", path}
+		},
+		parse: parseESLint,
+	},
+	{
+		name:       "golangci-lint",
+		binary:     "golangci-lint",
+		extensions: []string{".go"},
+		args: func(path string) []string {
+			return []string{"run", "--out-format", "json", path}
+		},
+		parse: parseGolangciLint,
+	},
+	{
+		name:       "phpcs",
+		binary:     "phpcs",
+		extensions: []string{".php"},
+		args: func(path string) []string {
+			return []string{"--report=json", path}
+		},
+		parse: parsePHPCS,
+	},
+}
+
+// Run executes the enabled analyzers that apply to a file and returns
+// their combined findings. Unknown names and missing binaries are skipped.
+func Run(enabled []string, path string) []Finding {
+	if len(enabled) == 0 {
+		return nil
+	}
+
+	enabledSet := make(map[string]bool, len(enabled))
+	for _, name := range enabled {
+		enabledSet[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+
+	ext := strings.ToLower(filepath.Ext(path))
+
+	var findings []Finding
+	for _, a := range supported {
+		if !enabledSet[a.name] || !hasExtension(a.extensions, ext) {
+			continue
+		}
+		if _, err := exec.LookPath(a.binary); err != nil {
+			continue
+		}
+
+		// Linters exit non-zero when findings exist, so ignore the error
+		// as long as the output parses
+		output, _ := exec.Command(a.binary, a.args(path)...).Output()
+		if len(output) == 0 {
+			continue
+		}
+		findings = append(findings, a.parse(output, path)...)
+	}
+	return findings
+}
+
+func hasExtension(extensions []string, ext string) bool {
+	for _, e := range extensions {
+		if e == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// parseESLint parses `eslint --format json` output
+func parseESLint(output []byte, path string) []Finding {
+	var results []struct {
+		Messages []struct {
+			Line     int    `json:"line"`
+			Severity int    `json:"severity"` // 1 = warning, 2 = error
+			RuleID   string `json:"ruleId"`
+			Message  string `json:"message"`
+		} `json:"messages"`
+	}
+	if err := json.Unmarshal(output, &results); err != nil {
+		return nil
+	}
+
+	var findings []Finding
+	for _, result := range results {
+		for _, m := range result.Messages {
+			severity := "warning"
+			if m.Severity >= 2 {
+				severity = "error"
+			}
+			findings = append(findings, Finding{
+				Tool:     "eslint",
+				Line:     m.Line,
+				Severity: severity,
+				Rule:     m.RuleID,
+				Message:  m.Message,
+			})
+		}
+	}
+	return findings
+}
+
+// parseGolangciLint parses `golangci-lint run --out-format json` output
+func parseGolangciLint(output []byte, path string) []Finding {
+	var result struct {
+		Issues []struct {
+			FromLinter string `json:"FromLinter"`
+			Text       string `json:"Text"`
+			Severity   string `json:"Severity"`
+			Pos        struct {
+				Filename string `json:"Filename"`
+				Line     int    `json:"Line"`
+			} `json:"Pos"`
+		} `json:"Issues"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil
+	}
+
+	var findings []Finding
+	for _, issue := range result.Issues {
+		severity := issue.Severity
+		if severity == "" {
+			severity = "warning"
+		}
+		findings = append(findings, Finding{
+			Tool:     "golangci-lint",
+			Line:     issue.Pos.Line,
+			Severity: severity,
+			Rule:     issue.FromLinter,
+			Message:  issue.Text,
+		})
+	}
+	return findings
+}
+
+// parsePHPCS parses `phpcs --report=json` output
+func parsePHPCS(output []byte, path string) []Finding {
+	var result struct {
+		Files map[string]struct {
+			Messages []struct {
+				Line    int    `json:"line"`
+				Type    string `json:"type"` // ERROR or WARNING
+				Source  string `json:"source"`
+				Message string `json:"message"`
+			} `json:"messages"`
+		} `json:"files"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil
+	}
+
+	var findings []Finding
+	for _, file := range result.Files {
+		for _, m := range file.Messages {
+			severity := "warning"
+			if strings.EqualFold(m.Type, "error") {
+				severity = "error"
+			}
+			findings = append(findings, Finding{
+				Tool:     "phpcs",
+				Line:     m.Line,
+				Severity: severity,
+				Rule:     m.Source,
+				Message:  m.Message,
+			})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,210 @@
+package coverage
+
+import (
+	"bufio"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Report holds per-file line/statement coverage percentages
+type Report struct {
+	// files maps file paths (as they appear in the report) to coverage 0-100
+	files map[string]float64
+	// Source is the coverage file the report was loaded from
+	Source string
+}
+
+// knownReportFiles are the coverage report locations checked at the repo root
+var knownReportFiles = []string{
+	"coverage.out",
+	"lcov.info",
+	"coverage/lcov.info",
+	"coverage.xml",
+}
+
+// Detect looks for a known coverage report at the repo root and loads it.
+// Returns nil if no report is found or it cannot be parsed.
+func Detect(repoRoot string) *Report {
+	for _, name := range knownReportFiles {
+		path := filepath.Join(repoRoot, name)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+
+		report, err := Load(path)
+		if err != nil {
+			continue
+		}
+		return report
+	}
+	return nil
+}
+
+// Load parses a coverage report file, choosing the parser by format
+func Load(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		files:  make(map[string]float64),
+		Source: path,
+	}
+
+	content := string(data)
+	switch {
+	case strings.HasPrefix(content, "mode:"):
+		err = report.parseGoCover(content)
+	case strings.Contains(content, "end_of_record"):
+		err = report.parseLcov(content)
+	default:
+		err = report.parseCobertura(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// FileCoverage returns the coverage percentage for a file path, matching
+// by suffix since reports may use package-qualified or absolute paths
+func (r *Report) FileCoverage(path string) (float64, bool) {
+	normalized := filepath.ToSlash(path)
+
+	if pct, ok := r.files[normalized]; ok {
+		return pct, true
+	}
+
+	for reportPath, pct := range r.files {
+		if strings.HasSuffix(reportPath, "/"+normalized) || strings.HasSuffix(normalized, "/"+reportPath) {
+			return pct, true
+		}
+	}
+
+	return 0, false
+}
+
+// parseGoCover parses a Go coverprofile (mode: set|count|atomic)
+func (r *Report) parseGoCover(content string) error {
+	type stmtCount struct{ total, covered int }
+	perFile := make(map[string]*stmtCount)
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "mode:") || line == "" {
+			continue
+		}
+
+		// file.go:startLine.startCol,endLine.endCol numStmts count
+		colonIdx := strings.LastIndex(line, ":")
+		if colonIdx < 0 {
+			continue
+		}
+		file := line[:colonIdx]
+		fields := strings.Fields(line[colonIdx+1:])
+		if len(fields) != 3 {
+			continue
+		}
+
+		numStmts, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+
+		sc, ok := perFile[file]
+		if !ok {
+			sc = &stmtCount{}
+			perFile[file] = sc
+		}
+		sc.total += numStmts
+		if count > 0 {
+			sc.covered += numStmts
+		}
+	}
+
+	for file, sc := range perFile {
+		if sc.total > 0 {
+			r.files[file] = 100 * float64(sc.covered) / float64(sc.total)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseLcov parses an lcov tracefile (SF/DA/LF/LH records)
+func (r *Report) parseLcov(content string) error {
+	var file string
+	var linesFound, linesHit int
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "SF:"):
+			file = filepath.ToSlash(strings.TrimPrefix(line, "SF:"))
+			linesFound, linesHit = 0, 0
+		case strings.HasPrefix(line, "DA:"):
+			parts := strings.Split(strings.TrimPrefix(line, "DA:"), ",")
+			if len(parts) < 2 {
+				continue
+			}
+			linesFound++
+			if count, err := strconv.Atoi(parts[1]); err == nil && count > 0 {
+				linesHit++
+			}
+		case strings.HasPrefix(line, "LF:"):
+			if n, err := strconv.Atoi(strings.TrimPrefix(line, "LF:")); err == nil {
+				linesFound = n
+			}
+		case strings.HasPrefix(line, "LH:"):
+			if n, err := strconv.Atoi(strings.TrimPrefix(line, "LH:")); err == nil {
+				linesHit = n
+			}
+		case line == "end_of_record":
+			if file != "" && linesFound > 0 {
+				r.files[file] = 100 * float64(linesHit) / float64(linesFound)
+			}
+			file = ""
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseCobertura parses a Cobertura coverage.xml report
+func (r *Report) parseCobertura(data []byte) error {
+	var doc struct {
+		Packages []struct {
+			Classes []struct {
+				Filename string `xml:"filename,attr"`
+				LineRate string `xml:"line-rate,attr"`
+			} `xml:"classes>class"`
+		} `xml:"packages>package"`
+	}
+
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+
+	for _, pkg := range doc.Packages {
+		for _, class := range pkg.Classes {
+			rate, err := strconv.ParseFloat(class.LineRate, 64)
+			if err != nil {
+				continue
+			}
+			r.files[filepath.ToSlash(class.Filename)] = 100 * rate
+		}
+	}
+
+	return nil
+}
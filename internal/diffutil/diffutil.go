@@ -0,0 +1,188 @@
+// Package diffutil computes unified diffs between code snippets, so
+// outputs can show exactly what a suggested fix changes instead of only
+// the replacement block.
+package diffutil
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is how many unchanged lines surround each hunk
+const contextLines = 3
+
+// Unified returns a unified diff between two snippets, with @@ hunk
+// headers numbered relative to the snippet (first line = 1). It returns
+// an empty string when the snippets are equal.
+func Unified(original, updated string) string {
+	a := splitLines(original)
+	b := splitLines(updated)
+
+	ops := diffOps(a, b)
+	changed := false
+	for _, op := range ops {
+		if op.kind != opEqual {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+
+	return renderHunks(ops)
+}
+
+// opKind classifies one line of a diff
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+// op is one diff operation: a line and what happened to it
+type op struct {
+	kind opKind
+	line string
+}
+
+// diffOps computes a line-level diff via longest common subsequence;
+// snippets are small, so the quadratic table is fine
+func diffOps(a, b []string) []op {
+	// lcs[i][j] = length of the LCS of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []op
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, op{opEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, op{opDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, op{opInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, op{opDelete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, op{opInsert, b[j]})
+	}
+	return ops
+}
+
+// renderHunks groups the operations into context-bounded hunks and emits
+// them in unified diff format
+func renderHunks(ops []op) string {
+	var sb strings.Builder
+
+	// Walk changes, extending each hunk while the gap of equal lines
+	// between changes fits inside twice the context
+	i := 0
+	oldLine, newLine := 1, 1
+	for i < len(ops) {
+		if ops[i].kind == opEqual {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+
+		// Back up to include leading context
+		start := i
+		context := 0
+		for start > 0 && ops[start-1].kind == opEqual && context < contextLines {
+			start--
+			context++
+		}
+		hunkOldStart := oldLine - context
+		hunkNewStart := newLine - context
+
+		// Advance to the end of this hunk
+		end := i
+		equalRun := 0
+		for end < len(ops) {
+			if ops[end].kind == opEqual {
+				equalRun++
+				if equalRun > contextLines*2 {
+					break
+				}
+			} else {
+				equalRun = 0
+			}
+			end++
+		}
+		// Trim trailing context beyond the limit
+		for end > i && equalRun > contextLines {
+			end--
+			equalRun--
+		}
+
+		oldCount, newCount := 0, 0
+		var body strings.Builder
+		for k := start; k < end; k++ {
+			switch ops[k].kind {
+			case opEqual:
+				body.WriteString(" " + ops[k].line + "\n")
+				oldCount++
+				newCount++
+			case opDelete:
+				body.WriteString("-" + ops[k].line + "\n")
+				oldCount++
+			case opInsert:
+				body.WriteString("+" + ops[k].line + "\n")
+				newCount++
+			}
+		}
+
+		sb.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", hunkOldStart, oldCount, hunkNewStart, newCount))
+		sb.WriteString(body.String())
+
+		// Account for the lines consumed past the current position
+		for k := i; k < end; k++ {
+			switch ops[k].kind {
+			case opEqual:
+				oldLine++
+				newLine++
+			case opDelete:
+				oldLine++
+			case opInsert:
+				newLine++
+			}
+		}
+		i = end
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// splitLines splits a snippet into lines, ignoring one trailing newline
+// so it doesn't register as a trailing empty line change
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,361 @@
+// Package gate evaluates small gating expressions against review results,
+// so hook/CI policies like
+//
+//	fail_if: "count(severity=='error') > 0 || count(category=='security' && confidence!='low') > 0"
+//
+// can be expressed in config instead of adding a flag per policy.
+package gate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// Eval evaluates a gating expression against a set of suggestions and
+// returns whether the gate fired (i.e., the commit should be blocked).
+func Eval(expr string, suggestions []review.Suggestion) (bool, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return false, err
+	}
+
+	p := &parser{tokens: tokens, suggestions: suggestions}
+	v, err := p.parseOr(nil)
+	if err != nil {
+		return false, err
+	}
+	if p.pos != len(p.tokens) {
+		return false, fmt.Errorf("unexpected token %q", p.tokens[p.pos])
+	}
+
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to a boolean, got %T", v)
+	}
+	return b, nil
+}
+
+// tokenize splits the expression into tokens: identifiers, numbers,
+// single-quoted or double-quoted strings, operators, and parentheses
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, string(r))
+			i++
+		case r == '\'' || r == '"':
+			quote := r
+			j := i + 1
+			for j < len(runes) && runes[j] != quote {
+				j++
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			tokens = append(tokens, "'"+string(runes[i+1:j]))
+			i = j + 1
+		case strings.ContainsRune("=!<>&|", r):
+			j := i + 1
+			for j < len(runes) && strings.ContainsRune("=!<>&|", runes[j]) {
+				j++
+			}
+			op := string(runes[i:j])
+			switch op {
+			case "==", "!=", "<", "<=", ">", ">=", "&&", "||", "!":
+				tokens = append(tokens, op)
+			default:
+				return nil, fmt.Errorf("invalid operator %q", op)
+			}
+			i = j
+		case unicode.IsDigit(r):
+			j := i
+			for j < len(runes) && unicode.IsDigit(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_') {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q", r)
+		}
+	}
+
+	return tokens, nil
+}
+
+// parser is a small recursive-descent parser/evaluator. Inside a count(...)
+// call, field identifiers resolve against the current suggestion.
+type parser struct {
+	tokens      []string
+	pos         int
+	suggestions []review.Suggestion
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *parser) parseOr(s *review.Suggestion) (interface{}, error) {
+	left, err := p.parseAnd(s)
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "||" {
+		p.next()
+		right, err := p.parseAnd(s)
+		if err != nil {
+			return nil, err
+		}
+		lb, rb, err := boolPair(left, right, "||")
+		if err != nil {
+			return nil, err
+		}
+		left = lb || rb
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd(s *review.Suggestion) (interface{}, error) {
+	left, err := p.parseNot(s)
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "&&" {
+		p.next()
+		right, err := p.parseNot(s)
+		if err != nil {
+			return nil, err
+		}
+		lb, rb, err := boolPair(left, right, "&&")
+		if err != nil {
+			return nil, err
+		}
+		left = lb && rb
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot(s *review.Suggestion) (interface{}, error) {
+	if p.peek() == "!" {
+		p.next()
+		v, err := p.parseNot(s)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operator ! requires a boolean operand")
+		}
+		return !b, nil
+	}
+	return p.parseComparison(s)
+}
+
+func (p *parser) parseComparison(s *review.Suggestion) (interface{}, error) {
+	left, err := p.parsePrimary(s)
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.peek()
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		p.next()
+		right, err := p.parsePrimary(s)
+		if err != nil {
+			return nil, err
+		}
+		return compare(left, right, op)
+	}
+
+	return left, nil
+}
+
+func (p *parser) parsePrimary(s *review.Suggestion) (interface{}, error) {
+	tok := p.next()
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+
+	case tok == "(":
+		v, err := p.parseOr(s)
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return v, nil
+
+	case strings.HasPrefix(tok, "'"):
+		return tok[1:], nil
+
+	case tok == "true":
+		return true, nil
+	case tok == "false":
+		return false, nil
+
+	case tok == "count":
+		if p.next() != "(" {
+			return nil, fmt.Errorf("count must be followed by (")
+		}
+		return p.evalCount()
+
+	case unicode.IsDigit(rune(tok[0])):
+		n, err := strconv.Atoi(tok)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", tok)
+		}
+		return n, nil
+
+	default:
+		// Field identifier - only valid inside count(...)
+		if s == nil {
+			return nil, fmt.Errorf("field %q can only be used inside count(...)", tok)
+		}
+		return fieldValue(s, tok)
+	}
+}
+
+// evalCount evaluates the predicate once per suggestion and counts matches.
+// The opening parenthesis has already been consumed.
+func (p *parser) evalCount() (interface{}, error) {
+	start := p.pos
+	count := 0
+
+	if len(p.suggestions) == 0 {
+		// Still parse the predicate for errors, against an empty suggestion
+		empty := review.Suggestion{}
+		if _, err := p.parseOr(&empty); err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range p.suggestions {
+		p.pos = start
+		v, err := p.parseOr(&p.suggestions[i])
+		if err != nil {
+			return nil, err
+		}
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("count predicate must be a boolean expression")
+		}
+		if b {
+			count++
+		}
+	}
+
+	if p.next() != ")" {
+		return nil, fmt.Errorf("missing closing parenthesis after count predicate")
+	}
+	return count, nil
+}
+
+// fieldValue resolves a suggestion field referenced inside count(...)
+func fieldValue(s *review.Suggestion, field string) (interface{}, error) {
+	switch field {
+	case "severity":
+		return string(s.Severity), nil
+	case "confidence":
+		return string(s.Confidence), nil
+	case "category":
+		return s.Category, nil
+	case "file":
+		return s.File, nil
+	case "source":
+		return s.Source, nil
+	case "line":
+		return s.Line, nil
+	default:
+		return nil, fmt.Errorf("unknown field %q (valid: severity, confidence, category, file, source, line)", field)
+	}
+}
+
+func compare(left, right interface{}, op string) (bool, error) {
+	switch l := left.(type) {
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return false, fmt.Errorf("cannot compare string with %T", right)
+		}
+		switch op {
+		case "==":
+			return l == r, nil
+		case "!=":
+			return l != r, nil
+		default:
+			return false, fmt.Errorf("operator %s not supported for strings", op)
+		}
+	case int:
+		r, ok := right.(int)
+		if !ok {
+			return false, fmt.Errorf("cannot compare number with %T", right)
+		}
+		switch op {
+		case "==":
+			return l == r, nil
+		case "!=":
+			return l != r, nil
+		case "<":
+			return l < r, nil
+		case "<=":
+			return l <= r, nil
+		case ">":
+			return l > r, nil
+		case ">=":
+			return l >= r, nil
+		}
+	case bool:
+		r, ok := right.(bool)
+		if !ok {
+			return false, fmt.Errorf("cannot compare boolean with %T", right)
+		}
+		switch op {
+		case "==":
+			return l == r, nil
+		case "!=":
+			return l != r, nil
+		default:
+			return false, fmt.Errorf("operator %s not supported for booleans", op)
+		}
+	}
+	return false, fmt.Errorf("unsupported operand type %T", left)
+}
+
+func boolPair(left, right interface{}, op string) (bool, bool, error) {
+	lb, ok := left.(bool)
+	if !ok {
+		return false, false, fmt.Errorf("operator %s requires boolean operands", op)
+	}
+	rb, ok := right.(bool)
+	if !ok {
+		return false, false, fmt.Errorf("operator %s requires boolean operands", op)
+	}
+	return lb, rb, nil
+}
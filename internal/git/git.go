@@ -7,17 +7,53 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
 // FileChange represents a changed file in git
 type FileChange struct {
-	Path      string
-	Status    string // A=added, M=modified, D=deleted, R=renamed
-	OldPath   string // For renamed files
-	Diff      string
-	Content   string
-	IsBinary  bool
+	Path       string
+	Status     string // A=added, M=modified, D=deleted, R=renamed
+	OldPath    string // For renamed files
+	Similarity int    // For renamed files: git's similarity score (100 = pure move)
+	Diff       string
+	Content    string
+	IsBinary   bool
+	Size       int64 // Staged size in bytes, known without loading content
+
+	// loader lazily fetches Diff and Content when the file is about to be
+	// reviewed, so large changesets don't hold every file in memory at once
+	loader func() (diff string, content string, err error)
+}
+
+// SetLoader installs a lazy loader for the change's diff and content
+func (c *FileChange) SetLoader(loader func() (diff string, content string, err error)) {
+	c.loader = loader
+}
+
+// EnsureLoaded populates Diff and Content, fetching them lazily if a
+// loader is installed. Changes without a loader are already fully loaded.
+func (c *FileChange) EnsureLoaded() error {
+	if c.loader == nil || c.Content != "" {
+		return nil
+	}
+	diff, content, err := c.loader()
+	if err != nil {
+		return err
+	}
+	c.Diff = diff
+	c.Content = content
+	return nil
+}
+
+// Release drops lazily loaded content so peak memory stays bounded while
+// iterating large changesets. Eagerly loaded changes are left untouched.
+func (c *FileChange) Release() {
+	if c.loader != nil {
+		c.Diff = ""
+		c.Content = ""
+	}
 }
 
 // IsGitRepo checks if the current directory is a git repository
@@ -55,12 +91,12 @@ func ReadFileContent(path string) (string, error) {
 		return "", err
 	}
 	defer file.Close()
-	
+
 	data, err := io.ReadAll(file)
 	if err != nil {
 		return "", err
 	}
-	
+
 	return string(data), nil
 }
 
@@ -95,12 +131,16 @@ func GetStagedChanges() ([]FileChange, error) {
 			Path:   parts[len(parts)-1],
 		}
 
-		// Handle renamed files (R100 old new)
+		// Handle renamed files (R100 old new); keep the similarity score so
+		// pure moves can skip AI review entirely
 		if strings.HasPrefix(change.Status, "R") {
 			if len(parts) >= 3 {
 				change.OldPath = parts[1]
 				change.Path = parts[2]
 			}
+			if score, err := strconv.Atoi(strings.TrimPrefix(change.Status, "R")); err == nil {
+				change.Similarity = score
+			}
 			change.Status = "R"
 		}
 
@@ -112,17 +152,30 @@ func GetStagedChanges() ([]FileChange, error) {
 		// Check if binary
 		change.IsBinary = isBinaryFile(change.Path)
 
-		// Get diff for non-binary files
+		// Record the staged size and defer diff/content loading until the
+		// file is actually reviewed, keeping peak memory bounded for large
+		// changesets
 		if !change.IsBinary {
-			diff, err := getStagedDiff(change.Path)
-			if err == nil {
-				change.Diff = diff
-			}
-
-			content, err := getStagedContent(change.Path)
-			if err == nil {
-				change.Content = content
-			}
+			change.Size = stagedSize(change.Path)
+			path := change.Path
+			oldPath := change.OldPath
+			change.SetLoader(func() (string, string, error) {
+				// For renames, diff both paths so git pairs old and new
+				// content and the diff shows only the actual edits
+				diffPaths := []string{path}
+				if oldPath != "" {
+					diffPaths = []string{oldPath, path}
+				}
+				diff, err := getStagedDiff(diffPaths...)
+				if err != nil {
+					return "", "", err
+				}
+				content, err := getStagedContent(path)
+				if err != nil {
+					return "", "", err
+				}
+				return diff, content, nil
+			})
 		}
 
 		changes = append(changes, change)
@@ -131,16 +184,48 @@ func GetStagedChanges() ([]FileChange, error) {
 	return changes, nil
 }
 
-// getStagedDiff returns the staged diff for a file
-func getStagedDiff(path string) (string, error) {
-	cmd := exec.Command("git", "diff", "--cached", "--", path)
+// stagedSize returns the staged size of a file in bytes, or 0 when unknown
+func stagedSize(path string) int64 {
+	cmd := exec.Command("git", "cat-file", "-s", ":"+path)
+	output, err := cmd.Output()
+	if err != nil {
+		return 0
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return size
+}
+
+// getStagedDiff returns the staged diff for one or more paths
+func getStagedDiff(paths ...string) (string, error) {
+	args := append([]string{"diff", "--cached", "--"}, paths...)
+	cmd := exec.Command("git", args...)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
-		return "", fmt.Errorf("git diff failed for %s: %w: %s", path, err, output)
+		return "", fmt.Errorf("git diff failed for %s: %w: %s", strings.Join(paths, ", "), err, output)
 	}
 	return string(output), nil
 }
 
+// StagedDiff returns the full staged diff across all paths
+func StagedDiff() (string, error) {
+	return getStagedDiff()
+}
+
+// Commit records the staged changes with the given message. The
+// pre-commit hook is skipped - the review it would run has just happened
+// in this process.
+func Commit(message string) error {
+	cmd := exec.Command("git", "commit", "--no-verify", "-m", message)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("git commit failed: %w: %s", err, output)
+	}
+	return nil
+}
+
 // getStagedContent returns the staged content of a file
 func getStagedContent(path string) (string, error) {
 	cmd := exec.Command("git", "show", ":"+path)
@@ -199,3 +284,42 @@ func GetCurrentBranch() (string, error) {
 	}
 	return strings.TrimSpace(string(output)), nil
 }
+
+// ReadPreparedCommitMessage returns the commit message being prepared
+// (from .git/COMMIT_EDITMSG), with comment lines stripped. Returns an
+// empty string when no message has been prepared.
+func ReadPreparedCommitMessage() string {
+	gitDir, err := GetGitDir()
+	if err != nil {
+		return ""
+	}
+
+	data, err := os.ReadFile(filepath.Join(gitDir, "COMMIT_EDITMSG"))
+	if err != nil {
+		return ""
+	}
+
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// PrereviewDir returns the directory under .git where prereview keeps its
+// working data (cache, history, transcripts), creating it if necessary
+func PrereviewDir() (string, error) {
+	gitDir, err := GetGitDir()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(gitDir, "prereview")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create prereview directory: %w", err)
+	}
+	return dir, nil
+}
@@ -0,0 +1,158 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// PermalinkBuilder constructs web links to file lines at a specific commit
+// on the repository's code host (GitHub, GitLab, Bitbucket)
+type PermalinkBuilder struct {
+	baseURL string // e.g., https://github.com/user/repo
+	host    string // github, gitlab, bitbucket
+	commit  string // Full commit hash the links pin to
+}
+
+// NewPermalinkBuilder detects the origin remote and current commit and
+// returns a builder, or nil when the remote is missing or unrecognized
+func NewPermalinkBuilder() *PermalinkBuilder {
+	remote, err := GetOriginURL()
+	if err != nil {
+		return nil
+	}
+
+	baseURL, host := normalizeRemote(remote)
+	if baseURL == "" {
+		return nil
+	}
+
+	commit, err := GetHeadCommit()
+	if err != nil {
+		return nil
+	}
+
+	return &PermalinkBuilder{
+		baseURL: baseURL,
+		host:    host,
+		commit:  commit,
+	}
+}
+
+// FileLink returns a permalink to a file (and optional line range) at the
+// pinned commit
+func (b *PermalinkBuilder) FileLink(path string, line, endLine int) string {
+	var link string
+	switch b.host {
+	case "gitlab":
+		link = fmt.Sprintf("%s/-/blob/%s/%s", b.baseURL, b.commit, path)
+		if line > 0 {
+			link += fmt.Sprintf("#L%d", line)
+			if endLine > line {
+				link += fmt.Sprintf("-%d", endLine)
+			}
+		}
+	case "bitbucket":
+		link = fmt.Sprintf("%s/src/%s/%s", b.baseURL, b.commit, path)
+		if line > 0 {
+			link += fmt.Sprintf("#lines-%d", line)
+			if endLine > line {
+				link += fmt.Sprintf(":%d", endLine)
+			}
+		}
+	default: // github
+		link = fmt.Sprintf("%s/blob/%s/%s", b.baseURL, b.commit, path)
+		if line > 0 {
+			link += fmt.Sprintf("#L%d", line)
+			if endLine > line {
+				link += fmt.Sprintf("-L%d", endLine)
+			}
+		}
+	}
+	return link
+}
+
+// normalizeRemote converts an SSH or HTTPS remote URL into a browsable
+// base URL and identifies the code host. Returns empty strings for
+// unrecognized hosts.
+func normalizeRemote(remote string) (baseURL string, host string) {
+	remote = strings.TrimSpace(remote)
+	remote = strings.TrimSuffix(remote, ".git")
+
+	// SSH form: git@github.com:user/repo
+	if strings.HasPrefix(remote, "git@") {
+		rest := strings.TrimPrefix(remote, "git@")
+		parts := strings.SplitN(rest, ":", 2)
+		if len(parts) != 2 {
+			return "", ""
+		}
+		remote = "https://" + parts[0] + "/" + parts[1]
+	}
+
+	// ssh:// form: ssh://git@github.com/user/repo
+	if strings.HasPrefix(remote, "ssh://") {
+		remote = "https://" + strings.TrimPrefix(strings.TrimPrefix(remote, "ssh://"), "git@")
+	}
+
+	switch {
+	case strings.Contains(remote, "github."):
+		host = "github"
+	case strings.Contains(remote, "gitlab."):
+		host = "gitlab"
+	case strings.Contains(remote, "bitbucket."):
+		host = "bitbucket"
+	default:
+		return "", ""
+	}
+
+	if !strings.HasPrefix(remote, "http://") && !strings.HasPrefix(remote, "https://") {
+		return "", ""
+	}
+
+	return remote, host
+}
+
+// OriginRepo identifies the code host and project path ("owner/repo",
+// possibly nested for GitLab groups) of the origin remote. Returns an
+// error when there is no remote or the host is unrecognized.
+func OriginRepo() (host string, project string, err error) {
+	remote, err := GetOriginURL()
+	if err != nil {
+		return "", "", err
+	}
+
+	baseURL, host := normalizeRemote(remote)
+	if baseURL == "" {
+		return "", "", fmt.Errorf("unrecognized origin remote %q", remote)
+	}
+
+	// Strip scheme and domain, leaving the project path
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(baseURL, "https://"), "http://")
+	if idx := strings.Index(trimmed, "/"); idx >= 0 {
+		project = strings.Trim(trimmed[idx+1:], "/")
+	}
+	if project == "" {
+		return "", "", fmt.Errorf("could not determine project path from remote %q", remote)
+	}
+	return host, project, nil
+}
+
+// GetOriginURL returns the URL of the origin remote
+func GetOriginURL() (string, error) {
+	cmd := exec.Command("git", "remote", "get-url", "origin")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git remote get-url failed: %w: %s", err, output)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetHeadCommit returns the full hash of the current HEAD commit
+func GetHeadCommit() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "HEAD")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse failed: %w: %s", err, output)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
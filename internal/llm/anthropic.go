@@ -0,0 +1,128 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// AnthropicClient talks directly to the Anthropic Messages API, for teams
+// with their own vendor contract
+type AnthropicClient struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewAnthropicClient creates a client for the Anthropic API. The key falls
+// back to ANTHROPIC_API_KEY.
+func NewAnthropicClient(baseURL, apiKey, caBundle string) (*AnthropicClient, error) {
+	if baseURL == "" {
+		baseURL = "https://api.anthropic.com"
+	}
+	if apiKey == "" {
+		apiKey = os.Getenv("ANTHROPIC_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("no API key configured: set api_key in config or the ANTHROPIC_API_KEY environment variable")
+	}
+
+	httpClient, err := newHTTPClient(120*time.Second, caBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnthropicClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}, nil
+}
+
+// anthropicRequest and anthropicResponse mirror the Messages API schema
+type anthropicRequest struct {
+	Model     string        `json:"model"`
+	MaxTokens int           `json:"max_tokens"`
+	System    string        `json:"system"`
+	Messages  []chatMessage `json:"messages"`
+}
+
+type anthropicResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Chat sends a message request to the Anthropic API
+func (c *AnthropicClient) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	if model == "" {
+		model = "claude-sonnet-4-5"
+	}
+
+	body, err := json.Marshal(anthropicRequest{
+		Model:     model,
+		MaxTokens: 8192,
+		System:    systemMessage,
+		Messages: []chatMessage{
+			{Role: "user", Content: prompt},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/v1/messages", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", c.apiKey)
+	req.Header.Set("anthropic-version", "2023-06-01")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var parsed anthropicResponse
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if parsed.Error != nil {
+		return "", classifyHTTP(resp.StatusCode, fmt.Errorf("API error (%s): %s", parsed.Error.Type, parsed.Error.Message))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", classifyHTTP(resp.StatusCode, fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(data))))
+	}
+
+	var text strings.Builder
+	for _, block := range parsed.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+	if text.Len() == 0 {
+		return "", fmt.Errorf("empty response from provider")
+	}
+	return text.String(), nil
+}
+
+// Close is a no-op for HTTP providers
+func (c *AnthropicClient) Close() {}
@@ -0,0 +1,168 @@
+package llm
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// cache wraps a provider with an on-disk response cache keyed by a hash of
+// model and prompt. Re-reviewing unchanged staged content - the common
+// review/fix/re-review flow - builds byte-identical prompts, so hits return
+// instantly without re-billing the provider.
+type cache struct {
+	inner Provider
+	dir   string
+	ttl   time.Duration // Entries older than this are ignored and pruned, 0 = keep forever
+}
+
+// newCache wraps inner with a response cache rooted at dir
+func newCache(inner Provider, dir string, ttl time.Duration) *cache {
+	c := &cache{inner: inner, dir: dir, ttl: ttl}
+	c.prune()
+	return c
+}
+
+// DefaultCacheDir returns the response cache location, typically
+// ~/.cache/prereview on Linux. Empty when no user cache dir is known.
+func DefaultCacheDir() string {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, "prereview")
+}
+
+// Chat returns a cached response when a fresh one exists for this exact
+// model and prompt, otherwise forwards to the wrapped provider and stores
+// the result
+func (c *cache) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	key := cacheKey(model, prompt)
+	if response, ok := c.lookup(key); ok {
+		return response, nil
+	}
+
+	response, err := c.inner.Chat(ctx, model, prompt)
+	if err == nil {
+		c.store(key, response)
+	}
+	return response, err
+}
+
+// ChatStream behaves like Chat; on a cache hit the full cached response is
+// delivered as a single delta so streaming callers still see content arrive
+func (c *cache) ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error) {
+	key := cacheKey(model, prompt)
+	if response, ok := c.lookup(key); ok {
+		if onDelta != nil {
+			onDelta(response)
+		}
+		return response, nil
+	}
+
+	streamer, ok := c.inner.(Streamer)
+	if !ok {
+		return c.Chat(ctx, model, prompt)
+	}
+	response, err := streamer.ChatStream(ctx, model, prompt, onDelta)
+	if err == nil {
+		c.store(key, response)
+	}
+	return response, err
+}
+
+// ListModels forwards to the wrapped provider when it supports listing
+func (c *cache) ListModels() ([]ModelInfo, error) {
+	if lister, ok := c.inner.(ModelLister); ok {
+		return lister.ListModels()
+	}
+	return nil, errNoModelListing
+}
+
+// LastUsage forwards to the wrapped provider when it reports usage. Cache
+// hits leave no usage behind, so hit responses fall back to estimation.
+func (c *cache) LastUsage() (promptTokens, completionTokens int, ok bool) {
+	if reporter, ok := c.inner.(UsageReporter); ok {
+		return reporter.LastUsage()
+	}
+	return 0, 0, false
+}
+
+// FailoverNotes forwards to the wrapped provider when it records failovers
+func (c *cache) FailoverNotes() []string {
+	if reporter, ok := c.inner.(FailoverReporter); ok {
+		return reporter.FailoverNotes()
+	}
+	return nil
+}
+
+// Ping forwards to the wrapped provider when it supports health checks
+func (c *cache) Ping(ctx context.Context) error {
+	if checker, ok := c.inner.(HealthChecker); ok {
+		return checker.Ping(ctx)
+	}
+	return nil
+}
+
+// Close closes the wrapped provider
+func (c *cache) Close() {
+	c.inner.Close()
+}
+
+// cacheKey derives the cache file name from model and prompt
+func cacheKey(model, prompt string) string {
+	sum := sha256.Sum256([]byte(model + "\x00" + prompt))
+	return fmt.Sprintf("%x.txt", sum)
+}
+
+// lookup returns the cached response for key when one exists and is fresh.
+// Expired entries are removed on sight.
+func (c *cache) lookup(key string) (string, bool) {
+	path := filepath.Join(c.dir, key)
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", false
+	}
+	if c.ttl > 0 && time.Since(info.ModTime()) > c.ttl {
+		_ = os.Remove(path)
+		return "", false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// store writes a response into the cache; failures are silently ignored
+// since the cache is purely an optimization
+func (c *cache) store(key, response string) {
+	if err := os.MkdirAll(c.dir, 0755); err != nil {
+		return
+	}
+	_ = os.WriteFile(filepath.Join(c.dir, key), []byte(response), 0644)
+}
+
+// prune removes expired entries so the cache doesn't grow without bound
+func (c *cache) prune() {
+	if c.ttl <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || entry.IsDir() {
+			continue
+		}
+		if time.Since(info.ModTime()) > c.ttl {
+			_ = os.Remove(filepath.Join(c.dir, entry.Name()))
+		}
+	}
+}
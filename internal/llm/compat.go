@@ -0,0 +1,77 @@
+package llm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// minCopilotVersion is the oldest Copilot CLI release prereview is tested
+// against. Older CLIs get a startup warning rather than a hard failure,
+// since most of the review flow still works.
+const minCopilotVersion = "0.3.0"
+
+// Feature minimums: these arrived in different CLI releases, so they are
+// gated on the detected version instead of failing mid-review with cryptic
+// SDK errors when an older CLI ignores them
+const (
+	streamingMinVersion     = "0.4.0" // assistant.message_delta events
+	systemMessageMinVersion = "0.3.0" // Custom system messages in session config
+)
+
+// copilotCaps records which optional features the installed Copilot CLI
+// supports, negotiated once at client startup
+type copilotCaps struct {
+	streaming     bool // Delivers assistant.message_delta events
+	systemMessage bool // Honors a custom system message in session config
+}
+
+// detectCapabilities derives the CLI's capabilities from its reported
+// version. Unparsable versions (dev builds, missing status support) are
+// assumed fully capable.
+func detectCapabilities(version string) copilotCaps {
+	parsed, ok := parseVersion(version)
+	if !ok {
+		return copilotCaps{streaming: true, systemMessage: true}
+	}
+	return copilotCaps{
+		streaming:     !versionBefore(parsed, streamingMinVersion),
+		systemMessage: !versionBefore(parsed, systemMessageMinVersion),
+	}
+}
+
+// parseVersion parses a dotted semver-style version ("1.2.3", "v0.4.0"),
+// ignoring any pre-release suffix
+func parseVersion(version string) ([3]int, bool) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if idx := strings.IndexAny(version, "-+"); idx >= 0 {
+		version = version[:idx]
+	}
+
+	var parsed [3]int
+	parts := strings.Split(version, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return parsed, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, false
+		}
+		parsed[i] = n
+	}
+	return parsed, true
+}
+
+// versionBefore reports whether parsed is older than the given minimum
+func versionBefore(parsed [3]int, minimum string) bool {
+	min, ok := parseVersion(minimum)
+	if !ok {
+		return false
+	}
+	for i := 0; i < 3; i++ {
+		if parsed[i] != min[i] {
+			return parsed[i] < min[i]
+		}
+	}
+	return false
+}
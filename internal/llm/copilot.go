@@ -0,0 +1,361 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	copilot "github.com/github/copilot-sdk/go"
+)
+
+// CopilotClient wraps the GitHub Copilot SDK client
+type CopilotClient struct {
+	sdkClient *copilot.Client
+	mu        sync.Mutex
+	caps      copilotCaps // Features the installed CLI version supports
+
+	// Persistent-session mode: one session is reused across the whole
+	// review instead of paying session startup latency per file
+	persistent   bool
+	session      *copilot.Session
+	sessionModel string // Model the persistent session was created with
+	sessionUsed  bool   // Whether the persistent session has answered before
+
+	// Token counts reported by the last assistant.usage event, consumed
+	// through LastUsage
+	lastPromptTokens     int
+	lastCompletionTokens int
+	hasUsage             bool
+}
+
+// contextResetPreamble separates files when a session is reused. The SDK
+// has no context-reset call, so the reset is instructed in the prompt.
+const contextResetPreamble = "Disregard all previously reviewed files in this conversation. " +
+	"Review the following change independently, as if this were a fresh session.\n\n"
+
+// NewCopilotClient creates a new Copilot SDK client. With persistent set,
+// one session is reused across Chat calls (with an explicit context reset
+// between them) instead of creating and destroying one per call. caBundle
+// is forwarded to the CLI subprocess for TLS-intercepting proxies.
+func NewCopilotClient(persistent bool, caBundle string) (*CopilotClient, error) {
+	// Check if Copilot CLI is available
+	cliPath := os.Getenv("COPILOT_CLI_PATH")
+	if cliPath == "" {
+		cliPath = "copilot"
+	}
+
+	// The CLI subprocess inherits our environment, so proxy settings
+	// (HTTPS_PROXY, NO_PROXY) pass through as-is; an extra CA bundle is
+	// handed to Node's standard knob unless the user already set one
+	if caBundle != "" && os.Getenv("NODE_EXTRA_CA_CERTS") == "" {
+		os.Setenv("NODE_EXTRA_CA_CERTS", caBundle)
+	}
+
+	client := copilot.NewClient(&copilot.ClientOptions{
+		CLIPath:  cliPath,
+		LogLevel: "error",
+	})
+
+	// Start the client (spawns Copilot CLI in server mode)
+	if err := client.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start Copilot CLI: %w. Please install copilot-cli: brew install copilot-cli", err)
+	}
+
+	// Negotiate capabilities from the CLI's reported version, so optional
+	// features degrade up front instead of erroring mid-review
+	caps := copilotCaps{streaming: true, systemMessage: true}
+	if status, err := client.GetStatus(); err == nil && status.Version != "" {
+		if parsed, ok := parseVersion(status.Version); ok && versionBefore(parsed, minCopilotVersion) {
+			fmt.Fprintf(os.Stderr, "prereview: Copilot CLI %s is older than the oldest supported release (%s) - some features are disabled, consider upgrading\n",
+				status.Version, minCopilotVersion)
+		}
+		caps = detectCapabilities(status.Version)
+	}
+
+	return &CopilotClient{
+		sdkClient:  client,
+		caps:       caps,
+		persistent: persistent,
+	}, nil
+}
+
+// Chat sends a chat completion request using the Copilot SDK
+func (c *CopilotClient) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	return c.ChatStream(ctx, model, prompt, nil)
+}
+
+// ChatStream behaves like Chat but invokes onDelta with each response chunk
+// as the model generates it, so callers can render live progress instead of
+// blocking silently until the session goes idle
+func (c *CopilotClient) ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Map model name
+	apiModel := mapModelName(model)
+
+	session, reused, err := c.acquireSession(apiModel)
+	if err != nil {
+		return "", err
+	}
+	if !c.persistent {
+		defer session.Destroy()
+	}
+
+	// A reused session still holds the previous file's conversation, so
+	// instruct an explicit context reset before the new prompt
+	if reused {
+		prompt = contextResetPreamble + prompt
+	}
+
+	// CLIs too old for custom system messages get the review instructions
+	// folded into the first prompt of the session instead
+	if !c.caps.systemMessage && !reused {
+		prompt = systemMessage + "\n\n" + prompt
+	}
+
+	// Collect the response both ways: deltas stream in as the model
+	// generates, and the final assistant.message carries the complete text.
+	// The full message wins when present so nothing is counted twice.
+	var deltas, full strings.Builder
+	done := make(chan bool)
+	var sessionErr error
+
+	// Subscribe to events
+	unsubscribe := session.On(func(event copilot.SessionEvent) {
+		switch event.Type {
+		case "assistant.message_delta":
+			if event.Data.DeltaContent != nil {
+				deltas.WriteString(*event.Data.DeltaContent)
+				// Live rendering only when the CLI version is known to
+				// stream reliably
+				if onDelta != nil && c.caps.streaming {
+					onDelta(*event.Data.DeltaContent)
+				}
+			}
+		case "assistant.message":
+			if event.Data.Content != nil {
+				full.WriteString(*event.Data.Content)
+			}
+		case "assistant.usage":
+			if event.Data.InputTokens != nil {
+				c.lastPromptTokens = int(*event.Data.InputTokens)
+				c.hasUsage = true
+			}
+			if event.Data.OutputTokens != nil {
+				c.lastCompletionTokens = int(*event.Data.OutputTokens)
+				c.hasUsage = true
+			}
+		case "session.idle":
+			close(done)
+		case "session.error":
+			if event.Data.Content != nil {
+				sessionErr = classify(fmt.Errorf("session error: %s", *event.Data.Content))
+			}
+			close(done)
+		}
+	})
+	defer unsubscribe()
+
+	// Send the prompt
+	_, err = session.Send(copilot.MessageOptions{
+		Prompt: prompt,
+	})
+	if err != nil {
+		return "", classify(fmt.Errorf("failed to send message: %w", err))
+	}
+
+	// Wait for completion, or abort when the context is cancelled or its
+	// deadline passes (Ctrl-C, request_timeout)
+	select {
+	case <-done:
+	case <-ctx.Done():
+		_ = session.Abort()
+		c.dropSession()
+		return "", ctx.Err()
+	}
+
+	if sessionErr != nil {
+		// A session that errored may be in a bad state - drop it so the
+		// next call starts fresh
+		c.dropSession()
+		return "", sessionErr
+	}
+
+	c.sessionUsed = true
+	if full.Len() > 0 {
+		return full.String(), nil
+	}
+	return deltas.String(), nil
+}
+
+// acquireSession returns the session to use for a request, creating one if
+// needed. In persistent mode the existing session is reused while the model
+// matches; reused reports whether it has answered a previous prompt.
+func (c *CopilotClient) acquireSession(apiModel string) (session *copilot.Session, reused bool, err error) {
+	if c.persistent && c.session != nil {
+		if c.sessionModel == apiModel {
+			return c.session, c.sessionUsed, nil
+		}
+		// Model changed mid-run - the old session can't serve it
+		c.dropSession()
+	}
+
+	config := &copilot.SessionConfig{Model: apiModel}
+	if c.caps.systemMessage {
+		config.SystemMessage = &copilot.SystemMessageConfig{
+			Mode:    "append",
+			Content: systemMessage,
+		}
+	}
+	session, err = c.sdkClient.CreateSession(config)
+	if err != nil {
+		return nil, false, classify(fmt.Errorf("failed to create session: %w", err))
+	}
+
+	if c.persistent {
+		c.session = session
+		c.sessionModel = apiModel
+		c.sessionUsed = false
+	}
+	return session, false, nil
+}
+
+// dropSession destroys the persistent session, if any
+func (c *CopilotClient) dropSession() {
+	if c.session != nil {
+		c.session.Destroy()
+		c.session = nil
+		c.sessionModel = ""
+		c.sessionUsed = false
+	}
+}
+
+// LastUsage returns the token counts the CLI reported for the most recent
+// request, consuming them so they aren't attributed to a later request
+func (c *CopilotClient) LastUsage() (promptTokens, completionTokens int, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.hasUsage {
+		return 0, 0, false
+	}
+	promptTokens, completionTokens = c.lastPromptTokens, c.lastCompletionTokens
+	c.lastPromptTokens, c.lastCompletionTokens, c.hasUsage = 0, 0, false
+	return promptTokens, completionTokens, true
+}
+
+// Ping verifies the Copilot CLI is responsive and the user holds an active
+// authentication, returning a typed error suitable for targeted remediation
+func (c *CopilotClient) Ping(ctx context.Context) error {
+	// The SDK calls take no context, so run them on the side and let ctx
+	// abandon a hung CLI; the goroutine ends when the call returns
+	done := make(chan error, 1)
+	go func() { done <- c.ping() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ping is the blocking health check behind Ping
+func (c *CopilotClient) ping() error {
+	if _, err := c.sdkClient.Ping(""); err != nil {
+		return classify(fmt.Errorf("Copilot CLI is not responding: %w", err))
+	}
+
+	status, err := c.sdkClient.GetAuthStatus()
+	if err != nil {
+		return classify(fmt.Errorf("failed to check authentication: %w", err))
+	}
+	if !status.IsAuthenticated {
+		return fmt.Errorf("%w: run 'copilot auth login'", ErrNotAuthenticated)
+	}
+	return nil
+}
+
+// ListModels queries the Copilot CLI for the models actually available to
+// the authenticated user
+func (c *CopilotClient) ListModels() ([]ModelInfo, error) {
+	infos, err := c.sdkClient.ListModels()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list models: %w", err)
+	}
+
+	models := make([]ModelInfo, 0, len(infos))
+	for _, info := range infos {
+		models = append(models, ModelInfo{ID: info.ID, Name: info.Name})
+	}
+	return models, nil
+}
+
+// CopilotModelID resolves a user-friendly model alias (claude, gpt-4, ...)
+// to the Copilot model identifier it maps to
+func CopilotModelID(model string) string {
+	return mapModelName(model)
+}
+
+// Close stops the Copilot CLI client
+func (c *CopilotClient) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dropSession()
+	if c.sdkClient != nil {
+		c.sdkClient.Stop()
+	}
+}
+
+// mapModelName maps user-friendly model names to Copilot model names
+// All models available through Copilot CLI are supported
+// See: https://docs.github.com/en/copilot/reference/ai-models/supported-models
+func mapModelName(model string) string {
+	switch strings.ToLower(model) {
+	// OpenAI models
+	case "gpt-4", "gpt4", "gpt-4o":
+		return "gpt-4o"
+	case "gpt-4o-mini", "gpt-4-mini":
+		return "gpt-4o-mini"
+	case "gpt-4.1":
+		return "gpt-4.1"
+	case "gpt-5":
+		return "gpt-5"
+	case "gpt-5-mini":
+		return "gpt-5-mini"
+	case "gpt-5.1":
+		return "gpt-5.1"
+	case "gpt-5.2":
+		return "gpt-5.2"
+	case "o1", "o1-preview":
+		return "o1-preview"
+	case "o1-mini":
+		return "o1-mini"
+
+	// Anthropic Claude models
+	case "claude", "claude-sonnet", "claude-sonnet-4":
+		return "claude-sonnet-4"
+	case "claude-sonnet-4.5":
+		return "claude-sonnet-4.5"
+	case "claude-opus", "claude-opus-4.5":
+		return "claude-opus-4.5"
+	case "claude-haiku", "claude-haiku-4.5":
+		return "claude-haiku-4.5"
+
+	// Google Gemini models
+	case "gemini", "gemini-2.5-pro":
+		return "gemini-2.5-pro"
+	case "gemini-3-flash":
+		return "gemini-3-flash"
+	case "gemini-3-pro":
+		return "gemini-3-pro"
+
+	// xAI Grok models
+	case "grok", "grok-code-fast":
+		return "grok-code-fast-1"
+
+	default:
+		return "gpt-4o-mini" // Default - good balance of quality and speed
+	}
+}
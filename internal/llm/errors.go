@@ -0,0 +1,71 @@
+package llm
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Typed provider errors. Providers wrap their raw failures with these
+// sentinels so callers can match with errors.Is and give targeted
+// remediation (log in, wait, pick another model, shrink the prompt)
+// instead of surfacing opaque SDK or HTTP messages.
+var (
+	ErrNotAuthenticated = errors.New("provider is not authenticated")
+	ErrRateLimited      = errors.New("provider rate limit exceeded")
+	ErrModelUnavailable = errors.New("requested model is unavailable")
+	ErrContextTooLarge  = errors.New("prompt exceeds the model's context window")
+)
+
+// classify wraps err with the matching typed error when its message is
+// recognizably one of the known failure modes, and returns it unchanged
+// otherwise
+func classify(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case containsAny(msg, "unauthorized", "not authenticated", "authentication", "invalid api key", "invalid_api_key", "forbidden", "please log in", "401", "403"):
+		return fmt.Errorf("%w: %v", ErrNotAuthenticated, err)
+	case containsAny(msg, "rate limit", "rate_limit", "too many requests", "quota exceeded", "429"):
+		return fmt.Errorf("%w: %v", ErrRateLimited, err)
+	case containsAny(msg, "model_not_found", "unknown model", "no such model", "model is not supported", "model not supported", "does not exist or you do not have access"):
+		return fmt.Errorf("%w: %v", ErrModelUnavailable, err)
+	case containsAny(msg, "context length", "context_length_exceeded", "maximum context", "too many tokens", "prompt is too long", "request too large"):
+		return fmt.Errorf("%w: %v", ErrContextTooLarge, err)
+	}
+	return err
+}
+
+// classifyHTTP is classify with the HTTP status as a stronger signal,
+// used by the HTTP providers where the status code is authoritative
+func classifyHTTP(statusCode int, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %v", ErrNotAuthenticated, err)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %v", ErrRateLimited, err)
+	case http.StatusNotFound:
+		return fmt.Errorf("%w: %v", ErrModelUnavailable, err)
+	case http.StatusRequestEntityTooLarge:
+		return fmt.Errorf("%w: %v", ErrContextTooLarge, err)
+	}
+	return classify(err)
+}
+
+// containsAny reports whether msg contains any of the markers
+func containsAny(msg string, markers ...string) bool {
+	for _, marker := range markers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
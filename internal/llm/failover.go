@@ -0,0 +1,188 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// FailoverReporter is implemented by the failover provider (and forwarded
+// by the wrappers) so the review summary can note which fallbacks were
+// actually used during a run
+type FailoverReporter interface {
+	FailoverNotes() []string
+}
+
+// failoverThreshold is how many consecutive errors the active provider
+// gets before the next one in the configured order takes over
+const failoverThreshold = 2
+
+// failover walks an ordered provider list (`providers` config key): the
+// first one that starts serves requests, and repeated mid-review errors
+// hand over to the next. Fallbacks are constructed lazily so a configured
+// backup doesn't cost a CLI spawn unless it's needed.
+type failover struct {
+	names []string
+	opts  Options
+
+	mu       sync.Mutex
+	active   Provider
+	label    string   // Name of the active provider
+	index    int      // Next candidate in names to try
+	failures int      // Consecutive errors on the active provider
+	notes    []string // Human-readable failover events for the summary
+}
+
+// newFailover creates a provider that fails over across names in order
+func newFailover(names []string, opts Options) *failover {
+	return &failover{names: names, opts: opts}
+}
+
+// Chat sends the prompt through the active provider, failing over to the
+// next configured one after repeated errors
+func (f *failover) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	return f.send(ctx, func(provider Provider) (string, error) {
+		return provider.Chat(ctx, model, prompt)
+	})
+}
+
+// ChatStream behaves like Chat, streaming when the active provider can
+func (f *failover) ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error) {
+	return f.send(ctx, func(provider Provider) (string, error) {
+		if streamer, ok := provider.(Streamer); ok {
+			return streamer.ChatStream(ctx, model, prompt, onDelta)
+		}
+		return provider.Chat(ctx, model, prompt)
+	})
+}
+
+// send runs one request against the active provider, advancing through the
+// configured order when errors repeat
+func (f *failover) send(ctx context.Context, do func(Provider) (string, error)) (string, error) {
+	for {
+		provider, err := f.current()
+		if err != nil {
+			return "", err
+		}
+
+		response, err := do(provider)
+		if err == nil {
+			f.mu.Lock()
+			f.failures = 0
+			f.mu.Unlock()
+			return response, nil
+		}
+
+		// Cancellation isn't the provider's fault - don't burn a fallback
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return "", err
+		}
+
+		if !f.recordFailure(err) {
+			return "", err
+		}
+	}
+}
+
+// current returns the active provider, constructing the next candidate in
+// order when none is active. Candidates that fail to start are skipped
+// with a note.
+func (f *failover) current() (Provider, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.active != nil {
+		return f.active, nil
+	}
+
+	for f.index < len(f.names) {
+		name := f.names[f.index]
+		f.index++
+
+		single := f.opts
+		single.Provider = name
+		provider, err := newProvider(single)
+		if err != nil {
+			f.notes = append(f.notes, fmt.Sprintf("Provider %s failed to start (%v) - skipped.", name, err))
+			continue
+		}
+
+		f.active = provider
+		f.label = name
+		f.failures = 0
+		return provider, nil
+	}
+
+	return nil, fmt.Errorf("all configured providers failed (%v)", f.names)
+}
+
+// recordFailure counts an error against the active provider and reports
+// whether a failover happened (so the caller should retry the request)
+func (f *failover) recordFailure(err error) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failures++
+	if f.failures < failoverThreshold || f.index >= len(f.names) {
+		return false
+	}
+
+	failed := f.label
+	f.active.Close()
+	f.active = nil
+	f.notes = append(f.notes, fmt.Sprintf("Provider %s failed repeatedly (%v) - failed over to %s.", failed, err, f.names[f.index]))
+	return true
+}
+
+// FailoverNotes returns the failover events recorded during this run
+func (f *failover) FailoverNotes() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.notes...)
+}
+
+// Ping checks the active provider, constructing it if needed
+func (f *failover) Ping(ctx context.Context) error {
+	provider, err := f.current()
+	if err != nil {
+		return err
+	}
+	if checker, ok := provider.(HealthChecker); ok {
+		return checker.Ping(ctx)
+	}
+	return nil
+}
+
+// ListModels forwards to the active provider when it supports listing
+func (f *failover) ListModels() ([]ModelInfo, error) {
+	provider, err := f.current()
+	if err != nil {
+		return nil, err
+	}
+	if lister, ok := provider.(ModelLister); ok {
+		return lister.ListModels()
+	}
+	return nil, errNoModelListing
+}
+
+// LastUsage forwards to the active provider when it reports usage
+func (f *failover) LastUsage() (promptTokens, completionTokens int, ok bool) {
+	f.mu.Lock()
+	provider := f.active
+	f.mu.Unlock()
+	if reporter, ok := provider.(UsageReporter); ok {
+		return reporter.LastUsage()
+	}
+	return 0, 0, false
+}
+
+// Close closes the active provider, if any was started
+func (f *failover) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active != nil {
+		f.active.Close()
+		f.active = nil
+	}
+}
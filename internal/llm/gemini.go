@@ -0,0 +1,136 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// GeminiClient talks directly to the Google Gemini API, for teams with
+// their own vendor contract
+type GeminiClient struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewGeminiClient creates a client for the Gemini API. The key falls back
+// to GEMINI_API_KEY, then GOOGLE_API_KEY.
+func NewGeminiClient(baseURL, apiKey, caBundle string) (*GeminiClient, error) {
+	if baseURL == "" {
+		baseURL = "https://generativelanguage.googleapis.com"
+	}
+	if apiKey == "" {
+		apiKey = os.Getenv("GEMINI_API_KEY")
+	}
+	if apiKey == "" {
+		apiKey = os.Getenv("GOOGLE_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("no API key configured: set api_key in config or the GEMINI_API_KEY environment variable")
+	}
+
+	httpClient, err := newHTTPClient(120*time.Second, caBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GeminiClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}, nil
+}
+
+// geminiRequest and geminiResponse mirror the generateContent schema
+type geminiRequest struct {
+	SystemInstruction geminiContent   `json:"systemInstruction"`
+	Contents          []geminiContent `json:"contents"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+	Error *struct {
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	} `json:"error"`
+}
+
+// Chat sends a generateContent request to the Gemini API
+func (c *GeminiClient) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	if model == "" {
+		model = "gemini-2.5-pro"
+	}
+
+	body, err := json.Marshal(geminiRequest{
+		SystemInstruction: geminiContent{Parts: []geminiPart{{Text: systemMessage}}},
+		Contents: []geminiContent{
+			{Role: "user", Parts: []geminiPart{{Text: prompt}}},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/v1beta/models/%s:generateContent", c.baseURL, model)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-goog-api-key", c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var parsed geminiResponse
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if parsed.Error != nil {
+		return "", classifyHTTP(resp.StatusCode, fmt.Errorf("API error (%s): %s", parsed.Error.Status, parsed.Error.Message))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", classifyHTTP(resp.StatusCode, fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(data))))
+	}
+
+	var text strings.Builder
+	for _, candidate := range parsed.Candidates {
+		for _, part := range candidate.Content.Parts {
+			text.WriteString(part.Text)
+		}
+		break // First candidate only
+	}
+	if text.Len() == 0 {
+		return "", fmt.Errorf("empty response from provider")
+	}
+	return text.String(), nil
+}
+
+// Close is a no-op for HTTP providers
+func (c *GeminiClient) Close() {}
@@ -0,0 +1,181 @@
+package llm
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter wraps a provider with a requests-per-minute budget and an
+// in-flight cap, so parallel review runs don't trip provider rate limits.
+// Requests over the budget queue rather than fail.
+type limiter struct {
+	inner     Provider
+	slots     chan struct{}    // Bounds in-flight requests, nil = unlimited
+	notify    func(queued int) // Optional callback when a request has to queue
+	perMinute int              // Requests allowed per rolling minute, 0 = unlimited
+
+	mu      sync.Mutex
+	stamps  []time.Time // Send times within the last minute
+	waiting int         // Requests currently queued
+}
+
+// newLimiter wraps inner, allowing at most maxInFlight concurrent requests
+// and perMinute requests per rolling minute (either 0 = unlimited)
+func newLimiter(inner Provider, maxInFlight, perMinute int, notify func(queued int)) *limiter {
+	l := &limiter{inner: inner, perMinute: perMinute, notify: notify}
+	if maxInFlight > 0 {
+		l.slots = make(chan struct{}, maxInFlight)
+	}
+	return l
+}
+
+// Chat waits for capacity, then forwards to the wrapped provider
+func (l *limiter) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	release, err := l.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return l.inner.Chat(ctx, model, prompt)
+}
+
+// ChatStream behaves like Chat, forwarding streaming when supported
+func (l *limiter) ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error) {
+	streamer, ok := l.inner.(Streamer)
+	if !ok {
+		return l.Chat(ctx, model, prompt)
+	}
+	release, err := l.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+	return streamer.ChatStream(ctx, model, prompt, onDelta)
+}
+
+// acquire blocks until both the in-flight cap and the per-minute budget
+// allow another request, or ctx is cancelled. The returned release frees
+// the in-flight slot.
+func (l *limiter) acquire(ctx context.Context) (release func(), err error) {
+	// In-flight cap first: a queued request shouldn't consume rate budget
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		default:
+			l.enqueue()
+			select {
+			case l.slots <- struct{}{}:
+				l.dequeue()
+			case <-ctx.Done():
+				l.dequeue()
+				return nil, ctx.Err()
+			}
+		}
+	}
+	freeSlot := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	// Rolling-minute budget: wait until the oldest request ages out
+	for {
+		wait := l.reserve()
+		if wait <= 0 {
+			break
+		}
+		l.enqueue()
+		select {
+		case <-time.After(wait):
+			l.dequeue()
+		case <-ctx.Done():
+			l.dequeue()
+			freeSlot()
+			return nil, ctx.Err()
+		}
+	}
+
+	return freeSlot, nil
+}
+
+// reserve records a send now when the per-minute budget allows it, or
+// returns how long to wait before trying again
+func (l *limiter) reserve() time.Duration {
+	if l.perMinute <= 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	fresh := l.stamps[:0]
+	for _, stamp := range l.stamps {
+		if now.Sub(stamp) < time.Minute {
+			fresh = append(fresh, stamp)
+		}
+	}
+	l.stamps = fresh
+
+	if len(l.stamps) >= l.perMinute {
+		return l.stamps[0].Add(time.Minute).Sub(now)
+	}
+	l.stamps = append(l.stamps, now)
+	return 0
+}
+
+// enqueue marks a request as queued and reports the new queue depth
+func (l *limiter) enqueue() {
+	l.mu.Lock()
+	l.waiting++
+	queued := l.waiting
+	l.mu.Unlock()
+	if l.notify != nil {
+		l.notify(queued)
+	}
+}
+
+// dequeue removes a request from the queue count
+func (l *limiter) dequeue() {
+	l.mu.Lock()
+	l.waiting--
+	l.mu.Unlock()
+}
+
+// ListModels forwards to the wrapped provider when it supports listing
+func (l *limiter) ListModels() ([]ModelInfo, error) {
+	if lister, ok := l.inner.(ModelLister); ok {
+		return lister.ListModels()
+	}
+	return nil, errNoModelListing
+}
+
+// LastUsage forwards to the wrapped provider when it reports usage
+func (l *limiter) LastUsage() (promptTokens, completionTokens int, ok bool) {
+	if reporter, ok := l.inner.(UsageReporter); ok {
+		return reporter.LastUsage()
+	}
+	return 0, 0, false
+}
+
+// FailoverNotes forwards to the wrapped provider when it records failovers
+func (l *limiter) FailoverNotes() []string {
+	if reporter, ok := l.inner.(FailoverReporter); ok {
+		return reporter.FailoverNotes()
+	}
+	return nil
+}
+
+// Ping forwards to the wrapped provider when it supports health checks
+func (l *limiter) Ping(ctx context.Context) error {
+	if checker, ok := l.inner.(HealthChecker); ok {
+		return checker.Ping(ctx)
+	}
+	return nil
+}
+
+// Close closes the wrapped provider
+func (l *limiter) Close() {
+	l.inner.Close()
+}
@@ -0,0 +1,146 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// OllamaClient talks to a local Ollama server, for air-gapped environments
+// where code cannot leave the machine
+type OllamaClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewOllamaClient creates a client for a local Ollama server. baseURL
+// defaults to the standard local endpoint; no API key is needed.
+func NewOllamaClient(baseURL string) (*OllamaClient, error) {
+	if baseURL == "" {
+		baseURL = "http://localhost:11434"
+	}
+	return &OllamaClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		// Local generation on CPU can be slow, so allow generous time
+		httpClient: &http.Client{Timeout: 10 * time.Minute},
+	}, nil
+}
+
+// ollamaRequest and ollamaResponse mirror Ollama's /api/chat schema
+type ollamaRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+type ollamaResponse struct {
+	Message chatMessage `json:"message"`
+	Error   string      `json:"error"`
+}
+
+// Chat sends a chat request to the local Ollama server
+func (c *OllamaClient) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	if model == "" {
+		model = "qwen2.5-coder"
+	}
+
+	body, err := json.Marshal(ollamaRequest{
+		Model: model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemMessage},
+			{Role: "user", Content: prompt},
+		},
+		Stream: false,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/chat", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed (is Ollama running?): %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var parsed ollamaResponse
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if parsed.Error != "" {
+		return "", classify(fmt.Errorf("ollama error: %s", parsed.Error))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", classifyHTTP(resp.StatusCode, fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(data))))
+	}
+
+	return parsed.Message.Content, nil
+}
+
+// ListModels queries the local server for pulled models
+func (c *OllamaClient) ListModels() ([]ModelInfo, error) {
+	return c.listModels(context.Background())
+}
+
+// listModels is the context-aware core of ListModels, shared with Ping so
+// the warm-up check can be cancelled or time out
+func (c *OllamaClient) listModels(ctx context.Context) ([]ModelInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/tags", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed (is Ollama running?): %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	var parsed struct {
+		Models []struct {
+			Name string `json:"name"`
+		} `json:"models"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse model listing: %w", err)
+	}
+
+	models := make([]ModelInfo, 0, len(parsed.Models))
+	for _, m := range parsed.Models {
+		models = append(models, ModelInfo{ID: m.Name, Name: m.Name})
+	}
+	return models, nil
+}
+
+// Ping verifies the local server is running, using the inexpensive
+// model-listing endpoint
+func (c *OllamaClient) Ping(ctx context.Context) error {
+	_, err := c.listModels(ctx)
+	return err
+}
+
+// Close is a no-op for HTTP providers
+func (c *OllamaClient) Close() {}
@@ -0,0 +1,173 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// OpenAIClient talks to any OpenAI-compatible chat completions endpoint
+// (OpenAI itself, Azure gateways, vLLM, LM Studio, ...)
+type OpenAIClient struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewOpenAIClient creates a client for an OpenAI-compatible endpoint.
+// baseURL defaults to the OpenAI API; the key falls back to OPENAI_API_KEY.
+func NewOpenAIClient(baseURL, apiKey, caBundle string) (*OpenAIClient, error) {
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+	}
+	if apiKey == "" {
+		apiKey = os.Getenv("OPENAI_API_KEY")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("no API key configured: set api_key in config or the OPENAI_API_KEY environment variable")
+	}
+
+	httpClient, err := newHTTPClient(120*time.Second, caBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OpenAIClient{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}, nil
+}
+
+// chatRequest and chatResponse mirror the OpenAI chat completions schema
+type chatRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error"`
+}
+
+// Chat sends a chat completion request to the configured endpoint
+func (c *OpenAIClient) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	if model == "" {
+		model = "gpt-4o-mini"
+	}
+
+	body, err := json.Marshal(chatRequest{
+		Model: model,
+		Messages: []chatMessage{
+			{Role: "system", Content: systemMessage},
+			{Role: "user", Content: prompt},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var parsed chatResponse
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return "", fmt.Errorf("failed to parse response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if parsed.Error != nil {
+		return "", classifyHTTP(resp.StatusCode, fmt.Errorf("API error (%s): %s", parsed.Error.Type, parsed.Error.Message))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", classifyHTTP(resp.StatusCode, fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(data))))
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("empty response from provider")
+	}
+
+	return parsed.Choices[0].Message.Content, nil
+}
+
+// ListModels queries the endpoint's /models listing
+func (c *OpenAIClient) ListModels() ([]ModelInfo, error) {
+	return c.listModels(context.Background())
+}
+
+// listModels is the context-aware core of ListModels, shared with Ping so
+// the warm-up check can be cancelled or time out
+func (c *OpenAIClient) listModels(ctx context.Context) ([]ModelInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/models", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	var parsed struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse model listing: %w", err)
+	}
+
+	models := make([]ModelInfo, 0, len(parsed.Data))
+	for _, m := range parsed.Data {
+		models = append(models, ModelInfo{ID: m.ID, Name: m.ID})
+	}
+	return models, nil
+}
+
+// Ping verifies the endpoint is reachable and the API key is accepted,
+// using the inexpensive model-listing endpoint
+func (c *OpenAIClient) Ping(ctx context.Context) error {
+	_, err := c.listModels(ctx)
+	return err
+}
+
+// Close is a no-op for HTTP providers
+func (c *OpenAIClient) Close() {}
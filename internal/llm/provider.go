@@ -0,0 +1,156 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Provider is a chat backend capable of answering review prompts. The
+// Copilot CLI is the default; OpenAI-compatible HTTP endpoints cover users
+// without a Copilot subscription or with self-hosted gateways, and Ollama
+// covers air-gapped machines reviewing against a local model.
+type Provider interface {
+	// Chat sends a prompt to the given model and returns the full response.
+	// Cancelling the context aborts the in-flight request.
+	Chat(ctx context.Context, model string, prompt string) (string, error)
+	// Close releases any resources the provider holds
+	Close()
+}
+
+// Streamer is implemented by providers that can deliver the response
+// incrementally as the model generates it. Callers fall back to Chat when
+// the configured provider doesn't implement it.
+type Streamer interface {
+	// ChatStream behaves like Chat but invokes onDelta with each chunk
+	ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error)
+}
+
+// ModelInfo describes one model a provider can serve
+type ModelInfo struct {
+	ID   string // Identifier to put in the model config key
+	Name string // Human-readable name, may equal ID
+}
+
+// ModelLister is implemented by providers that can enumerate the models
+// actually available to the authenticated user, replacing guesswork from
+// hardcoded model tables that go stale
+type ModelLister interface {
+	ListModels() ([]ModelInfo, error)
+}
+
+// errNoModelListing is returned by providers that can't enumerate models
+var errNoModelListing = fmt.Errorf("the configured provider does not support listing models")
+
+// HealthChecker is implemented by providers that can verify reachability
+// and authentication up front, so a review fails fast with a clear message
+// instead of erroring on the first file half a minute in
+type HealthChecker interface {
+	// Ping checks the provider is reachable and the user is authenticated
+	Ping(ctx context.Context) error
+}
+
+// UsageReporter is implemented by providers that report real token counts
+// for the most recent request. Callers estimate when it's unavailable.
+type UsageReporter interface {
+	// LastUsage returns and consumes the token counts of the last request
+	LastUsage() (promptTokens, completionTokens int, ok bool)
+}
+
+// Options configures provider selection
+type Options struct {
+	Provider          string           // "copilot" (default) or "openai"
+	BaseURL           string           // OpenAI-compatible endpoint base URL
+	APIKey            string           // API key for HTTP providers
+	MaxParallel       int              // Local providers: max concurrent generations, 0 = 1
+	MaxContext        int              // Local providers: prompt budget in estimated tokens, 0 = unlimited
+	PersistentSession bool             // Copilot: reuse one session across Chat calls
+	RetryAttempts     int              // Retries after a transient failure, 0 = no retries
+	RetryBackoff      time.Duration    // Initial retry delay, doubled per retry
+	CacheDir          string           // On-disk response cache directory, "" = caching disabled
+	CacheTTL          time.Duration    // How long cached responses stay valid, 0 = forever
+	MaxInFlight       int              // Max concurrent requests to the provider, 0 = unlimited
+	RequestsPerMinute int              // Requests allowed per rolling minute, 0 = unlimited
+	QueueNotify       func(queued int) // Called when a request queues behind the limiter
+	ExtraCABundle     string           // PEM file appended to the system roots, for TLS-intercepting proxies
+	Providers         []string         // Ordered failover list; overrides Provider when set
+}
+
+// New creates the configured provider
+func New(opts Options) (Provider, error) {
+	// Replay mode answers everything from golden files; no real provider
+	// (or network) is involved at all
+	if dir := os.Getenv(replayEnv); dir != "" {
+		return NewReplayer(dir), nil
+	}
+
+	// An ordered provider list fails over to the next entry when the
+	// active one can't start or errors repeatedly
+	var provider Provider
+	var err error
+	if len(opts.Providers) > 1 {
+		provider = newFailover(opts.Providers, opts)
+	} else {
+		single := opts
+		if len(opts.Providers) == 1 {
+			single.Provider = opts.Providers[0]
+		}
+		provider, err = newProvider(single)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Record mode sits innermost so golden files hold raw provider output
+	if dir := os.Getenv(recordEnv); dir != "" {
+		provider = NewRecorder(provider, dir)
+	}
+
+	// The limiter sits closest to the provider so retries also respect the
+	// rate budget
+	if opts.MaxInFlight > 0 || opts.RequestsPerMinute > 0 {
+		provider = newLimiter(provider, opts.MaxInFlight, opts.RequestsPerMinute, opts.QueueNotify)
+	}
+
+	// Transient failures (rate limits, transport errors) are retried with
+	// exponential backoff when configured
+	if opts.RetryAttempts > 0 {
+		provider = newRetrier(provider, opts.RetryAttempts, opts.RetryBackoff)
+	}
+
+	// The cache wraps outermost so hits skip retries and rate limiting too
+	if opts.CacheDir != "" {
+		provider = newCache(provider, opts.CacheDir, opts.CacheTTL)
+	}
+
+	return provider, nil
+}
+
+// newProvider constructs the backend selected by opts.Provider
+func newProvider(opts Options) (Provider, error) {
+	switch opts.Provider {
+	case "", "copilot":
+		return NewCopilotClient(opts.PersistentSession, opts.ExtraCABundle)
+	case "openai":
+		return NewOpenAIClient(opts.BaseURL, opts.APIKey, opts.ExtraCABundle)
+	case "ollama":
+		// Local models share the machine's GPU/CPU with the developer, so
+		// requests go through the hardware-limit scheduler
+		client, err := NewOllamaClient(opts.BaseURL)
+		if err != nil {
+			return nil, err
+		}
+		return NewScheduler(client, opts.MaxParallel, opts.MaxContext), nil
+	case "anthropic":
+		return NewAnthropicClient(opts.BaseURL, opts.APIKey, opts.ExtraCABundle)
+	case "gemini", "google":
+		return NewGeminiClient(opts.BaseURL, opts.APIKey, opts.ExtraCABundle)
+	default:
+		return nil, fmt.Errorf("unknown provider %q (supported: copilot, openai, ollama, anthropic, gemini)", opts.Provider)
+	}
+}
+
+// systemMessage is the shared system prompt all providers use
+const systemMessage = "You are a helpful code review assistant. Provide clear, actionable feedback on code changes. " +
+	"Focus on security vulnerabilities, performance issues, bug risks, code style, and best practices."
@@ -0,0 +1,127 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Record/replay transport: with PREREVIEW_RECORD set, real provider
+// responses are stored as golden files; with PREREVIEW_REPLAY set, those
+// files answer requests instead of the API. This makes parsing, fix
+// application, and interactive-session behavior reproducible without a
+// network or a Copilot subscription.
+const (
+	recordEnv = "PREREVIEW_RECORD"
+	replayEnv = "PREREVIEW_REPLAY"
+)
+
+// recorder wraps a provider and writes every response to a golden file
+// keyed by hash(model+prompt), in the same format the replayer reads
+type recorder struct {
+	inner Provider
+	dir   string
+}
+
+// NewRecorder wraps inner so responses are saved under dir for later replay
+func NewRecorder(inner Provider, dir string) Provider {
+	return &recorder{inner: inner, dir: dir}
+}
+
+// Chat forwards to the wrapped provider and records the response
+func (r *recorder) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	response, err := r.inner.Chat(ctx, model, prompt)
+	if err == nil {
+		r.save(model, prompt, response)
+	}
+	return response, err
+}
+
+// ChatStream forwards streaming when supported, recording the full response
+func (r *recorder) ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error) {
+	streamer, ok := r.inner.(Streamer)
+	if !ok {
+		return r.Chat(ctx, model, prompt)
+	}
+	response, err := streamer.ChatStream(ctx, model, prompt, onDelta)
+	if err == nil {
+		r.save(model, prompt, response)
+	}
+	return response, err
+}
+
+// save writes one golden file; failures are reported once on stderr since
+// silently missing recordings would surprise at replay time
+func (r *recorder) save(model, prompt, response string) {
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "prereview: failed to create recording dir: %v\n", err)
+		return
+	}
+	path := filepath.Join(r.dir, cacheKey(model, prompt))
+	if err := os.WriteFile(path, []byte(response), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "prereview: failed to record response: %v\n", err)
+	}
+}
+
+// ListModels forwards to the wrapped provider when it supports listing
+func (r *recorder) ListModels() ([]ModelInfo, error) {
+	if lister, ok := r.inner.(ModelLister); ok {
+		return lister.ListModels()
+	}
+	return nil, errNoModelListing
+}
+
+// LastUsage forwards to the wrapped provider when it reports usage
+func (r *recorder) LastUsage() (promptTokens, completionTokens int, ok bool) {
+	if reporter, ok := r.inner.(UsageReporter); ok {
+		return reporter.LastUsage()
+	}
+	return 0, 0, false
+}
+
+// Ping forwards to the wrapped provider when it supports health checks
+func (r *recorder) Ping(ctx context.Context) error {
+	if checker, ok := r.inner.(HealthChecker); ok {
+		return checker.Ping(ctx)
+	}
+	return nil
+}
+
+// Close closes the wrapped provider
+func (r *recorder) Close() {
+	r.inner.Close()
+}
+
+// Replayer answers requests from previously recorded golden files without
+// contacting any provider
+type Replayer struct {
+	dir string
+}
+
+// NewReplayer creates a provider that serves recorded responses from dir
+func NewReplayer(dir string) *Replayer {
+	return &Replayer{dir: dir}
+}
+
+// Chat returns the recorded response for this exact model and prompt
+func (r *Replayer) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	key := cacheKey(model, prompt)
+	data, err := os.ReadFile(filepath.Join(r.dir, key))
+	if err != nil {
+		return "", fmt.Errorf("no recorded response for this prompt (%s): %w", key, err)
+	}
+	return string(data), nil
+}
+
+// ChatStream delivers the recorded response as a single delta
+func (r *Replayer) ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error) {
+	response, err := r.Chat(ctx, model, prompt)
+	if err == nil && onDelta != nil {
+		onDelta(response)
+	}
+	return response, err
+}
+
+// Close implements Provider; the replayer holds no resources
+func (r *Replayer) Close() {}
@@ -0,0 +1,150 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// retrier wraps a provider and retries transient failures (rate limits,
+// transport hiccups) with exponential backoff, so a single flaky response
+// doesn't cause a whole file to be skipped. Permanent errors - bad API
+// keys, unknown models, cancellation - fail immediately.
+type retrier struct {
+	inner    Provider
+	attempts int           // Retries after the first try
+	backoff  time.Duration // Initial delay, doubled per retry
+}
+
+// newRetrier wraps inner with retry logic. attempts is the number of
+// retries after the initial try; backoff is the first retry's delay.
+func newRetrier(inner Provider, attempts int, backoff time.Duration) *retrier {
+	if backoff <= 0 {
+		backoff = 2 * time.Second
+	}
+	return &retrier{inner: inner, attempts: attempts, backoff: backoff}
+}
+
+// Chat forwards to the wrapped provider, retrying transient errors
+func (p *retrier) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	return p.retry(ctx, func() (string, error) {
+		return p.inner.Chat(ctx, model, prompt)
+	})
+}
+
+// ChatStream forwards streaming requests when the wrapped provider supports
+// them, with the same retry behavior; otherwise it degrades to Chat
+func (p *retrier) ChatStream(ctx context.Context, model string, prompt string, onDelta func(delta string)) (string, error) {
+	streamer, ok := p.inner.(Streamer)
+	if !ok {
+		return p.Chat(ctx, model, prompt)
+	}
+	return p.retry(ctx, func() (string, error) {
+		return streamer.ChatStream(ctx, model, prompt, onDelta)
+	})
+}
+
+// ListModels forwards to the wrapped provider when it supports listing
+func (p *retrier) ListModels() ([]ModelInfo, error) {
+	if lister, ok := p.inner.(ModelLister); ok {
+		return lister.ListModels()
+	}
+	return nil, errNoModelListing
+}
+
+// LastUsage forwards to the wrapped provider when it reports usage
+func (p *retrier) LastUsage() (promptTokens, completionTokens int, ok bool) {
+	if reporter, ok := p.inner.(UsageReporter); ok {
+		return reporter.LastUsage()
+	}
+	return 0, 0, false
+}
+
+// FailoverNotes forwards to the wrapped provider when it records failovers
+func (p *retrier) FailoverNotes() []string {
+	if reporter, ok := p.inner.(FailoverReporter); ok {
+		return reporter.FailoverNotes()
+	}
+	return nil
+}
+
+// Ping forwards to the wrapped provider when it supports health checks
+func (p *retrier) Ping(ctx context.Context) error {
+	if checker, ok := p.inner.(HealthChecker); ok {
+		return checker.Ping(ctx)
+	}
+	return nil
+}
+
+// Close closes the wrapped provider
+func (p *retrier) Close() {
+	p.inner.Close()
+}
+
+// retry runs send until it succeeds, fails permanently, or the attempts
+// are exhausted, backing off exponentially between tries
+func (p *retrier) retry(ctx context.Context, send func() (string, error)) (string, error) {
+	var lastErr error
+	delay := p.backoff
+
+	for attempt := 0; attempt <= p.attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+			delay *= 2
+		}
+
+		response, err := send()
+		if err == nil {
+			return response, nil
+		}
+		if !isTransient(err) {
+			return "", err
+		}
+		lastErr = err
+	}
+
+	return "", fmt.Errorf("giving up after %d attempt(s): %w", p.attempts+1, lastErr)
+}
+
+// isTransient reports whether an error is worth retrying: rate limiting,
+// server overload, or transport-level failures. Context cancellation and
+// anything that looks like a configuration problem are permanent.
+func isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	// Typed errors decide directly: rate limits recover, the rest need the
+	// user to fix something first
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+	if errors.Is(err, ErrNotAuthenticated) || errors.Is(err, ErrModelUnavailable) || errors.Is(err, ErrContextTooLarge) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, marker := range []string{
+		"rate limit", "rate_limit", "too many requests", "429",
+		"overloaded", "502", "503", "504",
+		"connection refused", "connection reset", "broken pipe",
+		"unexpected eof", "timeout", "temporarily unavailable",
+	} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package llm
+
+import "context"
+
+// Scheduler wraps a local provider and serializes requests according to
+// configured hardware limits, so multi-file reviews and watch-style usage
+// don't thrash a laptop GPU with parallel generations or oversized contexts
+type Scheduler struct {
+	inner      Provider
+	slots      chan struct{} // Bounds in-flight generations
+	maxContext int           // Prompt budget in estimated tokens, 0 = unlimited
+}
+
+// NewScheduler creates a scheduler allowing at most maxParallel concurrent
+// generations and trimming prompts above maxContext estimated tokens
+func NewScheduler(inner Provider, maxParallel, maxContext int) *Scheduler {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+	return &Scheduler{
+		inner:      inner,
+		slots:      make(chan struct{}, maxParallel),
+		maxContext: maxContext,
+	}
+}
+
+// Chat waits for a generation slot, enforces the context budget, and
+// forwards the request to the wrapped provider
+func (s *Scheduler) Chat(ctx context.Context, model string, prompt string) (string, error) {
+	select {
+	case s.slots <- struct{}{}:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	defer func() { <-s.slots }()
+
+	if s.maxContext > 0 {
+		// ~4 chars per token, same heuristic the review budget uses
+		maxChars := s.maxContext * 4
+		if len(prompt) > maxChars {
+			prompt = prompt[:maxChars] + "\n... (truncated to fit local context limit)"
+		}
+	}
+
+	return s.inner.Chat(ctx, model, prompt)
+}
+
+// ListModels forwards to the wrapped provider when it supports listing
+func (s *Scheduler) ListModels() ([]ModelInfo, error) {
+	if lister, ok := s.inner.(ModelLister); ok {
+		return lister.ListModels()
+	}
+	return nil, errNoModelListing
+}
+
+// LastUsage forwards to the wrapped provider when it reports usage
+func (s *Scheduler) LastUsage() (promptTokens, completionTokens int, ok bool) {
+	if reporter, ok := s.inner.(UsageReporter); ok {
+		return reporter.LastUsage()
+	}
+	return 0, 0, false
+}
+
+// Ping forwards to the wrapped provider when it supports health checks
+func (s *Scheduler) Ping(ctx context.Context) error {
+	if checker, ok := s.inner.(HealthChecker); ok {
+		return checker.Ping(ctx)
+	}
+	return nil
+}
+
+// Close closes the wrapped provider
+func (s *Scheduler) Close() {
+	s.inner.Close()
+}
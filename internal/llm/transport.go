@@ -0,0 +1,36 @@
+package llm
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// newHTTPClient builds the HTTP client shared by the HTTP providers.
+// Proxy settings come from the standard environment (HTTPS_PROXY,
+// HTTP_PROXY, NO_PROXY), and caBundle - the extra_ca_bundle config key -
+// appends extra roots to the system pool so TLS-intercepting corporate
+// proxies don't break every request with certificate errors.
+func newHTTPClient(timeout time.Duration, caBundle string) (*http.Client, error) {
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+
+	if caBundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := os.ReadFile(caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read extra_ca_bundle: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("extra_ca_bundle %s contains no valid PEM certificates", caBundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return &http.Client{Timeout: timeout, Transport: transport}, nil
+}
@@ -0,0 +1,89 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// CheckstyleFormatter emits the Checkstyle XML format consumed by older CI
+// plugins (Jenkins warnings-ng and various IDE integrations): one <file>
+// element per reviewed file with an <error> element per finding
+type CheckstyleFormatter struct{}
+
+type checkstyleDocument struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// Name implements Formatter
+func (f *CheckstyleFormatter) Name() string { return "checkstyle" }
+
+// Format renders the result as a Checkstyle XML document
+func (f *CheckstyleFormatter) Format(result *review.ReviewResult) (string, error) {
+	doc := checkstyleDocument{Version: "8.0"}
+
+	byFile := groupByFile(result.Suggestions)
+	for _, file := range result.Files {
+		suggestions := byFile[file]
+		if len(suggestions) == 0 {
+			continue
+		}
+		entry := checkstyleFile{Name: file}
+		for _, s := range suggestions {
+			entry.Errors = append(entry.Errors, checkstyleError{
+				Line:     s.Line,
+				Severity: checkstyleSeverity(s.Severity),
+				Message:  annotationMessage(s),
+				Source:   checkstyleSource(s),
+			})
+		}
+		doc.Files = append(doc.Files, entry)
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode Checkstyle XML: %w", err)
+	}
+	return xml.Header + string(data) + "\n", nil
+}
+
+// checkstyleSeverity maps review severities onto Checkstyle's
+// error/warning/info levels
+func checkstyleSeverity(sev review.Severity) string {
+	switch sev {
+	case review.SeverityError:
+		return "error"
+	case review.SeverityWarning:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// checkstyleSource identifies the rule that produced a finding; Checkstyle
+// consumers group warnings by this value
+func checkstyleSource(s review.Suggestion) string {
+	origin := s.Source
+	if origin == "" {
+		origin = "ai"
+	}
+	if s.Category != "" {
+		return fmt.Sprintf("prereview.%s.%s", origin, s.Category)
+	}
+	return "prereview." + origin
+}
@@ -0,0 +1,194 @@
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// DigestData is the aggregated history a digest is rendered from
+type DigestData struct {
+	Since time.Time
+	Repos map[string][]review.HistoryRecord // Repo label -> records in range
+}
+
+// GenerateDigest renders a team-facing digest of review activity as
+// markdown, or as a standalone HTML fragment when html is set
+func GenerateDigest(data DigestData, html bool) string {
+	agg := aggregate(data)
+	if html {
+		return renderDigestHTML(data, agg)
+	}
+	return renderDigestMarkdown(data, agg)
+}
+
+// digestAggregate holds the numbers shared by both renderings
+type digestAggregate struct {
+	runs       int
+	files      int
+	findings   int
+	fixed      int
+	categories []categoryCount
+	security   []string // "repo: title (file)" lines, most recent first
+	repoLines  []string // "repo: N runs, M findings, K fixed"
+}
+
+type categoryCount struct {
+	name  string
+	count int
+}
+
+// aggregate folds per-repo history records into digest numbers
+func aggregate(data DigestData) digestAggregate {
+	var agg digestAggregate
+	byCategory := make(map[string]int)
+
+	repoNames := make([]string, 0, len(data.Repos))
+	for name := range data.Repos {
+		repoNames = append(repoNames, name)
+	}
+	sort.Strings(repoNames)
+
+	for _, name := range repoNames {
+		records := data.Repos[name]
+		repoFindings := 0
+		repoFixed := 0
+		for _, record := range records {
+			agg.runs++
+			agg.files += record.Files
+			agg.fixed += record.Fixed
+			repoFixed += record.Fixed
+			for _, finding := range record.Findings {
+				agg.findings++
+				repoFindings++
+				category := finding.Category
+				if category == "" {
+					category = "uncategorized"
+				}
+				byCategory[category]++
+
+				// Security findings and outright errors are worth naming
+				if finding.Category == review.CategorySecurity || finding.Severity == string(review.SeverityError) {
+					agg.security = append(agg.security, fmt.Sprintf("%s: %s (%s)", name, finding.Title, finding.File))
+				}
+			}
+		}
+		agg.repoLines = append(agg.repoLines, fmt.Sprintf("%s: %d run(s), %d finding(s), %d fixed", name, len(records), repoFindings, repoFixed))
+	}
+
+	for name, count := range byCategory {
+		agg.categories = append(agg.categories, categoryCount{name, count})
+	}
+	sort.Slice(agg.categories, func(i, j int) bool {
+		if agg.categories[i].count != agg.categories[j].count {
+			return agg.categories[i].count > agg.categories[j].count
+		}
+		return agg.categories[i].name < agg.categories[j].name
+	})
+
+	return agg
+}
+
+// fixRate formats fixed/findings as a percentage
+func fixRate(fixed, findings int) string {
+	if findings == 0 {
+		return "n/a"
+	}
+	return fmt.Sprintf("%d%%", fixed*100/findings)
+}
+
+const maxDigestSecurityFindings = 10
+
+func renderDigestMarkdown(data DigestData, agg digestAggregate) string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("# PreReview digest — since %s\n\n", data.Since.Format("2006-01-02")))
+	sb.WriteString(fmt.Sprintf("- %d review run(s) across %d repo(s), %d file(s) reviewed\n", agg.runs, len(data.Repos), agg.files))
+	sb.WriteString(fmt.Sprintf("- %d finding(s), %d fixed (fix rate %s)\n\n", agg.findings, agg.fixed, fixRate(agg.fixed, agg.findings)))
+
+	if len(agg.categories) > 0 {
+		sb.WriteString("## Top categories\n\n")
+		for i, c := range agg.categories {
+			if i >= 5 {
+				break
+			}
+			sb.WriteString(fmt.Sprintf("- %s: %d\n", c.name, c.count))
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(agg.security) > 0 {
+		sb.WriteString("## Notable security findings\n\n")
+		for i, line := range agg.security {
+			if i >= maxDigestSecurityFindings {
+				sb.WriteString(fmt.Sprintf("- ...and %d more\n", len(agg.security)-maxDigestSecurityFindings))
+				break
+			}
+			sb.WriteString("- " + line + "\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(agg.repoLines) > 1 {
+		sb.WriteString("## Per repository\n\n")
+		for _, line := range agg.repoLines {
+			sb.WriteString("- " + line + "\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("*Generated by prereview on %s*\n", time.Now().Format("2006-01-02")))
+	return sb.String()
+}
+
+func renderDigestHTML(data DigestData, agg digestAggregate) string {
+	var sb strings.Builder
+
+	sb.WriteString("<h1>PreReview digest — since " + data.Since.Format("2006-01-02") + "</h1>\n")
+	sb.WriteString("<ul>\n")
+	sb.WriteString(fmt.Sprintf("<li>%d review run(s) across %d repo(s), %d file(s) reviewed</li>\n", agg.runs, len(data.Repos), agg.files))
+	sb.WriteString(fmt.Sprintf("<li>%d finding(s), %d fixed (fix rate %s)</li>\n", agg.findings, agg.fixed, fixRate(agg.fixed, agg.findings)))
+	sb.WriteString("</ul>\n")
+
+	if len(agg.categories) > 0 {
+		sb.WriteString("<h2>Top categories</h2>\n<ul>\n")
+		for i, c := range agg.categories {
+			if i >= 5 {
+				break
+			}
+			sb.WriteString(fmt.Sprintf("<li>%s: %d</li>\n", htmlEscape(c.name), c.count))
+		}
+		sb.WriteString("</ul>\n")
+	}
+
+	if len(agg.security) > 0 {
+		sb.WriteString("<h2>Notable security findings</h2>\n<ul>\n")
+		for i, line := range agg.security {
+			if i >= maxDigestSecurityFindings {
+				sb.WriteString(fmt.Sprintf("<li>...and %d more</li>\n", len(agg.security)-maxDigestSecurityFindings))
+				break
+			}
+			sb.WriteString("<li>" + htmlEscape(line) + "</li>\n")
+		}
+		sb.WriteString("</ul>\n")
+	}
+
+	if len(agg.repoLines) > 1 {
+		sb.WriteString("<h2>Per repository</h2>\n<ul>\n")
+		for _, line := range agg.repoLines {
+			sb.WriteString("<li>" + htmlEscape(line) + "</li>\n")
+		}
+		sb.WriteString("</ul>\n")
+	}
+
+	return sb.String()
+}
+
+// htmlEscape escapes the characters that matter in element content
+func htmlEscape(s string) string {
+	replacer := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	return replacer.Replace(s)
+}
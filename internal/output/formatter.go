@@ -0,0 +1,34 @@
+package output
+
+import (
+	"fmt"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// Formatter renders a review result for a particular consumer. Each
+// implementation is selected by the --format flag value its Name returns.
+type Formatter interface {
+	// Name is the --format value that selects this formatter
+	Name() string
+	// Format renders the result as a complete document
+	Format(result *review.ReviewResult) (string, error)
+}
+
+// ForFormat returns the formatter registered under name
+func ForFormat(name string) (Formatter, error) {
+	switch name {
+	case "json":
+		return &JSONFormatter{}, nil
+	case "github":
+		return &GitHubFormatter{}, nil
+	case "junit":
+		return &JUnitFormatter{}, nil
+	case "checkstyle":
+		return &CheckstyleFormatter{}, nil
+	case "tap":
+		return &TAPFormatter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (supported: json, github, junit, checkstyle, tap)", name)
+	}
+}
@@ -0,0 +1,62 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// GitHubFormatter emits GitHub Actions workflow commands
+// (::error/::warning/::notice with file and line properties), so findings
+// appear as inline annotations on the PR diff when prereview runs in CI
+type GitHubFormatter struct{}
+
+// Name implements Formatter
+func (f *GitHubFormatter) Name() string { return "github" }
+
+// Format renders one workflow command per suggestion
+func (f *GitHubFormatter) Format(result *review.ReviewResult) (string, error) {
+	var sb strings.Builder
+	for _, s := range result.Suggestions {
+		command := "notice"
+		switch s.Severity {
+		case review.SeverityError:
+			command = "error"
+		case review.SeverityWarning:
+			command = "warning"
+		}
+
+		sb.WriteString(fmt.Sprintf("::%s file=%s,line=%d", command, s.File, s.Line))
+		if s.EndLine > s.Line {
+			sb.WriteString(fmt.Sprintf(",endLine=%d", s.EndLine))
+		}
+		sb.WriteString(fmt.Sprintf(",title=%s::%s\n",
+			escapeProperty(s.Title), escapeData(annotationMessage(s))))
+	}
+	return sb.String(), nil
+}
+
+// annotationMessage combines title and description into the annotation body
+func annotationMessage(s review.Suggestion) string {
+	if s.Description == "" {
+		return s.Title
+	}
+	return s.Title + ": " + s.Description
+}
+
+// escapeData escapes a workflow command's message per the Actions spec
+func escapeData(s string) string {
+	s = strings.ReplaceAll(s, "%", "%25")
+	s = strings.ReplaceAll(s, "\r", "%0D")
+	s = strings.ReplaceAll(s, "\n", "%0A")
+	return s
+}
+
+// escapeProperty escapes a workflow command property value per the spec
+func escapeProperty(s string) string {
+	s = escapeData(s)
+	s = strings.ReplaceAll(s, ":", "%3A")
+	s = strings.ReplaceAll(s, ",", "%2C")
+	return s
+}
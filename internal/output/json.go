@@ -0,0 +1,136 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/emilushi/prereview/internal/diffutil"
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// JSONFormatter writes the full review result as machine-readable JSON,
+// for scripts and editor integrations
+type JSONFormatter struct{}
+
+// jsonSuggestion mirrors review.Suggestion with stable snake_case keys, so
+// the internal struct can evolve without breaking consumers
+type jsonSuggestion struct {
+	File         string   `json:"file"`
+	Line         int      `json:"line"`
+	EndLine      int      `json:"end_line,omitempty"`
+	Severity     string   `json:"severity"`
+	Confidence   string   `json:"confidence,omitempty"`
+	Category     string   `json:"category,omitempty"`
+	Title        string   `json:"title"`
+	Description  string   `json:"description,omitempty"`
+	OriginalCode string   `json:"original_code,omitempty"`
+	SuggestedFix string   `json:"suggested_fix,omitempty"`
+	Diff         string   `json:"diff,omitempty"` // Unified diff of original_code -> suggested_fix
+	Unfixable    bool     `json:"unfixable,omitempty"`
+	Source       string   `json:"source,omitempty"`
+	Provenance   []string `json:"provenance,omitempty"`
+}
+
+// jsonResult is the document shape --format json emits
+type jsonResult struct {
+	Files       []string           `json:"files"`
+	Summary     string             `json:"summary,omitempty"`
+	Coverage    map[string]float64 `json:"coverage,omitempty"`
+	Suggestions []jsonSuggestion   `json:"suggestions"`
+	Usage       review.Usage       `json:"usage"`
+	Stats       jsonStats          `json:"stats"`
+}
+
+// jsonStats mirrors the post-review statistics block: totals, severity
+// and category breakdowns, and per-file cost
+type jsonStats struct {
+	FilesReviewed int            `json:"files_reviewed"`
+	FilesFlagged  int            `json:"files_flagged"`
+	BySeverity    map[string]int `json:"by_severity,omitempty"`
+	ByCategory    map[string]int `json:"by_category,omitempty"`
+	Files         []jsonFileStat `json:"files,omitempty"`
+}
+
+// jsonFileStat is one file's share of the run
+type jsonFileStat struct {
+	File     string  `json:"file"`
+	Findings int     `json:"findings"`
+	Seconds  float64 `json:"seconds"`
+	Tokens   int     `json:"tokens"`
+}
+
+// buildStats converts a result's aggregate breakdown into the JSON shape
+func buildStats(result *review.ReviewResult) jsonStats {
+	stats := result.Stats()
+
+	flagged := make(map[string]bool)
+	for _, s := range result.Suggestions {
+		flagged[s.File] = true
+	}
+
+	out := jsonStats{
+		FilesReviewed: len(result.Files),
+		FilesFlagged:  len(flagged),
+	}
+	if len(stats.BySeverity) > 0 {
+		out.BySeverity = make(map[string]int, len(stats.BySeverity))
+		for sev, count := range stats.BySeverity {
+			out.BySeverity[string(sev)] = count
+		}
+	}
+	if len(stats.ByCategory) > 0 {
+		out.ByCategory = stats.ByCategory
+	}
+	for _, fs := range result.FileStats {
+		out.Files = append(out.Files, jsonFileStat{
+			File:     fs.File,
+			Findings: fs.Findings,
+			Seconds:  fs.Seconds,
+			Tokens:   fs.Tokens,
+		})
+	}
+	return out
+}
+
+// Name implements Formatter
+func (f *JSONFormatter) Name() string { return "json" }
+
+// Format renders the result as indented JSON with a trailing newline
+func (f *JSONFormatter) Format(result *review.ReviewResult) (string, error) {
+	out := jsonResult{
+		Files:       result.Files,
+		Summary:     result.Summary,
+		Coverage:    result.Coverage,
+		Suggestions: make([]jsonSuggestion, 0, len(result.Suggestions)),
+		Usage:       result.Usage,
+		Stats:       buildStats(result),
+	}
+	for _, s := range result.Suggestions {
+		diff := ""
+		if s.OriginalCode != "" && s.OriginalCode != "N/A" && s.SuggestFix != "" && s.SuggestFix != "N/A" {
+			diff = diffutil.Unified(s.OriginalCode, s.SuggestFix)
+		}
+		out.Suggestions = append(out.Suggestions, jsonSuggestion{
+			File:         s.File,
+			Line:         s.Line,
+			EndLine:      s.EndLine,
+			Severity:     string(s.Severity),
+			Confidence:   string(s.Confidence),
+			Category:     s.Category,
+			Title:        s.Title,
+			Description:  s.Description,
+			OriginalCode: s.OriginalCode,
+			SuggestedFix: s.SuggestFix,
+			Diff:         diff,
+			Unfixable:    s.Unfixable,
+			Source:       s.Source,
+			Provenance:   s.Provenance,
+		})
+	}
+
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode result: %w", err)
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,98 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// JUnitFormatter maps the review result onto the JUnit XML schema: one
+// test suite per reviewed file, one test case per suggestion (failed for
+// errors and warnings, skipped for info/hints), so CI systems that only
+// understand JUnit can display and gate on prereview results
+type JUnitFormatter struct{}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// Name implements Formatter
+func (f *JUnitFormatter) Name() string { return "junit" }
+
+// Format renders the result as a JUnit XML document
+func (f *JUnitFormatter) Format(result *review.ReviewResult) (string, error) {
+	suites := junitTestSuites{Name: "prereview"}
+
+	byFile := groupByFile(result.Suggestions)
+	for _, file := range result.Files {
+		suite := junitTestSuite{Name: file}
+		for _, s := range byFile[file] {
+			testCase := junitTestCase{
+				Name:      fmt.Sprintf("%s (line %d)", s.Title, s.Line),
+				ClassName: file,
+			}
+			message := &junitMessage{
+				Message: s.Title,
+				Type:    string(s.Severity),
+				Body:    annotationMessage(s),
+			}
+			switch s.Severity {
+			case review.SeverityError, review.SeverityWarning:
+				testCase.Failure = message
+				suite.Failures++
+			default:
+				testCase.Skipped = message
+				suite.Skipped++
+			}
+			suite.Cases = append(suite.Cases, testCase)
+		}
+
+		// A file with no findings still shows up as one passing case, so
+		// clean runs render as green rather than empty
+		if len(suite.Cases) == 0 {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      "no findings",
+				ClassName: file,
+			})
+		}
+
+		suite.Tests = len(suite.Cases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JUnit XML: %w", err)
+	}
+	return xml.Header + string(data) + "\n", nil
+}
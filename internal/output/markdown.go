@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/emilushi/prereview/internal/diffutil"
+	"github.com/emilushi/prereview/internal/git"
 	"github.com/emilushi/prereview/internal/review"
 )
 
@@ -26,7 +28,8 @@ func NewMarkdownGenerator(outputDir string) *MarkdownGenerator {
 	}
 }
 
-// GenerateSuggestionsFile creates a markdown file with all suggestions
+// GenerateSuggestionsFile creates a markdown file with all suggestions,
+// named after the current commit hash
 func (g *MarkdownGenerator) GenerateSuggestionsFile(result *review.ReviewResult) (string, error) {
 	if result == nil {
 		return "", fmt.Errorf("review result cannot be nil")
@@ -35,15 +38,23 @@ func (g *MarkdownGenerator) GenerateSuggestionsFile(result *review.ReviewResult)
 	filename := fmt.Sprintf("suggestions_%s.md", commitHash)
 
 	// Try to find .github folder location for output
-	outputPath := g.findOutputPath(filename)
+	return g.GenerateSuggestionsFileAt(result, g.findOutputPath(filename))
+}
 
-	content := g.generateMarkdownContent(result, commitHash)
+// GenerateSuggestionsFileAt writes the suggestions markdown to a caller-chosen
+// path, so CI jobs can use a stable filename (--output review.md) instead of
+// one derived from the commit hash
+func (g *MarkdownGenerator) GenerateSuggestionsFileAt(result *review.ReviewResult, path string) (string, error) {
+	if result == nil {
+		return "", fmt.Errorf("review result cannot be nil")
+	}
+	content := g.generateMarkdownContent(result, getShortCommitHash())
 
-	if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
 		return "", fmt.Errorf("failed to write markdown file: %w", err)
 	}
 
-	return outputPath, nil
+	return path, nil
 }
 
 // findOutputPath determines where to save the markdown file
@@ -75,6 +86,9 @@ func (g *MarkdownGenerator) findOutputPath(filename string) string {
 func (g *MarkdownGenerator) generateMarkdownContent(result *review.ReviewResult, commitHash string) string {
 	var sb strings.Builder
 
+	// Permalinks to the code host, when an origin remote is recognized
+	permalinks := git.NewPermalinkBuilder()
+
 	// Header
 	sb.WriteString("# Code Review Suggestions\n\n")
 	sb.WriteString(fmt.Sprintf("**Generated:** %s  \n", time.Now().Format("2006-01-02 15:04:05")))
@@ -87,13 +101,19 @@ func (g *MarkdownGenerator) generateMarkdownContent(result *review.ReviewResult,
 		return sb.String()
 	}
 
-	// Table of contents
+	// Overall AI assessment
+	if result.Summary != "" {
+		sb.WriteString("## Overall Assessment\n\n")
+		sb.WriteString(result.Summary + "\n\n")
+	}
+
+	// Summary table with severity counts
 	sb.WriteString("## Summary\n\n")
 	sb.WriteString("| Severity | Count |\n")
 	sb.WriteString("|----------|-------|\n")
 
 	severityCounts := countBySeverity(result.Suggestions)
-	for _, sev := range []review.Severity{review.SeverityError, review.SeverityWarning, review.SeverityInfo, review.SeverityHint} {
+	for _, sev := range severityOrder {
 		if count, ok := severityCounts[sev]; ok && count > 0 {
 			sb.WriteString(fmt.Sprintf("| %s | %d |\n", severityEmoji(sev), count))
 		}
@@ -103,7 +123,16 @@ func (g *MarkdownGenerator) generateMarkdownContent(result *review.ReviewResult,
 	// Group suggestions by file
 	byFile := groupByFile(result.Suggestions)
 
-	sb.WriteString("## Suggestions by File\n\n")
+	// Table of contents linking to per-file sections
+	sb.WriteString("## Files\n\n")
+	for _, file := range result.Files {
+		suggestions, ok := byFile[file]
+		if !ok || len(suggestions) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("- [`%s`](#%s) — %d suggestion(s)\n", file, markdownAnchor(file), len(suggestions)))
+	}
+	sb.WriteString("\n## Suggestions by File\n\n")
 
 	for _, file := range result.Files {
 		suggestions, ok := byFile[file]
@@ -113,60 +142,152 @@ func (g *MarkdownGenerator) generateMarkdownContent(result *review.ReviewResult,
 
 		sb.WriteString(fmt.Sprintf("### 📄 `%s`\n\n", file))
 
-		for i, sug := range suggestions {
-			sb.WriteString(fmt.Sprintf("#### %d. %s %s\n\n", i+1, severityEmoji(sug.Severity), sug.Title))
-
-			// Location
-			if sug.Line > 0 {
-				if sug.EndLine > 0 && sug.EndLine != sug.Line {
-					sb.WriteString(fmt.Sprintf("**Lines:** %d-%d  \n", sug.Line, sug.EndLine))
-				} else {
-					sb.WriteString(fmt.Sprintf("**Line:** %d  \n", sug.Line))
-				}
-			}
+		if pct, ok := result.Coverage[file]; ok {
+			sb.WriteString(fmt.Sprintf("**Test Coverage:** %.1f%%  \n\n", pct))
+		}
 
-			// Category
-			if sug.Category != "" {
-				sb.WriteString(fmt.Sprintf("**Category:** `%s`  \n\n", sug.Category))
-			} else {
-				sb.WriteString("\n")
-			}
+		// Within a file, errors come first and hints last
+		i := 0
+		for _, sug := range sortBySeverity(suggestions) {
+			i++
+			g.writeSuggestion(&sb, sug, i, permalinks)
+		}
+	}
 
-			// Description
-			sb.WriteString(sug.Description + "\n\n")
-
-			// Original code and suggested fix
-			if sug.OriginalCode != "" && sug.OriginalCode != "N/A" {
-				sb.WriteString("**Original Code:**\n\n")
-				sb.WriteString("```\n")
-				sb.WriteString(sug.OriginalCode)
-				if !strings.HasSuffix(sug.OriginalCode, "\n") {
-					sb.WriteString("\n")
-				}
-				sb.WriteString("```\n\n")
-			}
+	// Footer with the run's aggregate breakdown
+	sb.WriteString("\n---\n\n")
+	stats := result.Stats()
+	if line := stats.SeverityLine(); line != "" {
+		sb.WriteString("**By severity:** " + line + "  \n")
+	}
+	if line := stats.CategoryLine(); line != "" {
+		sb.WriteString("**By category:** " + line + "  \n")
+	}
+	if len(stats.Models) > 0 {
+		sb.WriteString("**Models:** " + strings.Join(stats.Models, ", ") + "  \n")
+	}
+	if len(result.FileStats) > 0 {
+		sb.WriteString("\n**Per file:**\n\n")
+		sb.WriteString("| File | Findings | Time | Tokens |\n")
+		sb.WriteString("|------|----------|------|--------|\n")
+		for _, fs := range result.FileStats {
+			sb.WriteString(fmt.Sprintf("| `%s` | %d | %.1fs | %d |\n", fs.File, fs.Findings, fs.Seconds, fs.Tokens))
+		}
+	}
+	sb.WriteString("\n*Generated by [PreReview](https://github.com/emilushi/prereview)*\n")
 
-			if sug.SuggestFix != "" && sug.SuggestFix != "N/A" {
-				sb.WriteString("**Suggested Fix:**\n\n")
-				sb.WriteString("```\n")
-				sb.WriteString(sug.SuggestFix)
-				if !strings.HasSuffix(sug.SuggestFix, "\n") {
-					sb.WriteString("\n")
-				}
-				sb.WriteString("```\n\n")
-			}
+	return sb.String()
+}
 
-			sb.WriteString("---\n\n")
+// writeSuggestion renders one suggestion as a collapsible section whose
+// summary line carries the severity, title, and location
+func (g *MarkdownGenerator) writeSuggestion(sb *strings.Builder, sug review.Suggestion, index int, permalinks *git.PermalinkBuilder) {
+	location := ""
+	if sug.Line > 0 {
+		if sug.EndLine > 0 && sug.EndLine != sug.Line {
+			location = fmt.Sprintf(" (lines %d-%d)", sug.Line, sug.EndLine)
+		} else {
+			location = fmt.Sprintf(" (line %d)", sug.Line)
 		}
 	}
 
-	// Footer
-	sb.WriteString("\n---\n\n")
-	sb.WriteString("*Generated by [PreReview](https://github.com/emilushi/prereview)*\n")
+	sb.WriteString("<details>\n")
+	sb.WriteString(fmt.Sprintf("<summary><strong>%d. %s %s</strong>%s</summary>\n\n",
+		index, severityEmoji(sug.Severity), sug.Title, location))
 
+	if sug.Line > 0 && permalinks != nil {
+		sb.WriteString(fmt.Sprintf("**Link:** %s  \n", permalinks.FileLink(sug.File, sug.Line, sug.EndLine)))
+	}
+
+	// Category and ID (for `prereview apply --suggestion <id>`)
+	if sug.Category != "" {
+		sb.WriteString(fmt.Sprintf("**Category:** `%s`  \n", sug.Category))
+	}
+	sb.WriteString(fmt.Sprintf("**ID:** `%s`  \n\n", review.SuggestionID(sug)))
+
+	// Description
+	sb.WriteString(sug.Description + "\n\n")
+
+	// Original code and suggested fix rendered as one diff block
+	if block := diffBlock(sug.OriginalCode, sug.SuggestFix); block != "" {
+		sb.WriteString(block)
+	}
+
+	sb.WriteString("</details>\n\n")
+}
+
+// diffBlock renders original code and suggested fix as a ```diff fence so
+// code hosts color the removal and addition lines. When both sides are
+// present a real unified diff shows only what actually changes.
+func diffBlock(original, fix string) string {
+	original = normalizeSnippet(original)
+	fix = normalizeSnippet(fix)
+	if original == "" && fix == "" {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("```diff\n")
+	if original != "" && fix != "" {
+		if unified := diffutil.Unified(original, fix); unified != "" {
+			sb.WriteString(unified + "\n")
+		}
+	} else if original != "" {
+		for _, line := range strings.Split(original, "\n") {
+			sb.WriteString("- " + line + "\n")
+		}
+	} else {
+		for _, line := range strings.Split(fix, "\n") {
+			sb.WriteString("+ " + line + "\n")
+		}
+	}
+	sb.WriteString("```\n\n")
 	return sb.String()
 }
 
+// normalizeSnippet strips trailing newlines and the model's "N/A" placeholder
+func normalizeSnippet(code string) string {
+	if code == "N/A" {
+		return ""
+	}
+	return strings.TrimRight(code, "\n")
+}
+
+// markdownAnchor converts a file path to the heading anchor GitHub generates
+// for its "### 📄 `path`" section
+func markdownAnchor(file string) string {
+	anchor := "-" + strings.ToLower(file)
+	for _, ch := range []string{"/", ".", " "} {
+		anchor = strings.ReplaceAll(anchor, ch, "")
+	}
+	return anchor
+}
+
+// sortBySeverity returns the suggestions ordered errors-first, preserving the
+// original order within each severity
+func sortBySeverity(suggestions []review.Suggestion) []review.Suggestion {
+	ordered := make([]review.Suggestion, 0, len(suggestions))
+	seen := make(map[int]bool)
+	for _, sev := range severityOrder {
+		for i, s := range suggestions {
+			if s.Severity == sev {
+				ordered = append(ordered, s)
+				seen[i] = true
+			}
+		}
+	}
+	// Anything with an unrecognized severity keeps its place at the end
+	for i, s := range suggestions {
+		if !seen[i] {
+			ordered = append(ordered, s)
+		}
+	}
+	return ordered
+}
+
+// severityOrder is the display order for grouped output, most severe first
+var severityOrder = []review.Severity{review.SeverityError, review.SeverityWarning, review.SeverityInfo, review.SeverityHint}
+
 // getShortCommitHash returns the short hash of the current HEAD or staged changes
 func getShortCommitHash() string {
 	// Try to get HEAD commit hash
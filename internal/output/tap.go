@@ -0,0 +1,56 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// TAPFormatter emits the Test Anything Protocol: one test point per
+// reviewed file (ok when the file has no error or warning findings), so
+// pre-commit frameworks and TAP harnesses can show per-file pass/fail
+type TAPFormatter struct{}
+
+// Name implements Formatter
+func (f *TAPFormatter) Name() string { return "tap" }
+
+// Format renders the result as a TAP version 13 stream
+func (f *TAPFormatter) Format(result *review.ReviewResult) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("TAP version 13\n")
+	sb.WriteString(fmt.Sprintf("1..%d\n", len(result.Files)))
+
+	byFile := groupByFile(result.Suggestions)
+	for i, file := range result.Files {
+		suggestions := byFile[file]
+		failing := 0
+		for _, s := range suggestions {
+			if s.Severity == review.SeverityError || s.Severity == review.SeverityWarning {
+				failing++
+			}
+		}
+
+		if failing == 0 {
+			sb.WriteString(fmt.Sprintf("ok %d - %s\n", i+1, file))
+		} else {
+			sb.WriteString(fmt.Sprintf("not ok %d - %s\n", i+1, file))
+		}
+
+		// Findings go in diagnostic lines so harnesses that only parse
+		// test points still count correctly
+		for _, s := range sortBySeverity(suggestions) {
+			sb.WriteString(fmt.Sprintf("# %s line %d [%s]: %s\n",
+				file, s.Line, s.Severity, tapSafe(annotationMessage(s))))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// tapSafe flattens a message onto one line; TAP diagnostics are line-based
+func tapSafe(msg string) string {
+	msg = strings.ReplaceAll(msg, "\r\n", " ")
+	msg = strings.ReplaceAll(msg, "\n", " ")
+	return msg
+}
@@ -0,0 +1,285 @@
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// GitHubOptions configures a publish run against the GitHub API
+type GitHubOptions struct {
+	Token   string // API token (GITHUB_TOKEN)
+	Repo    string // "owner/repo", detected from the origin remote when empty
+	PR      int    // Pull request number
+	BaseURL string // API base, defaults to https://api.github.com
+}
+
+// GitHubPublisher posts a review result to a pull request as a PR review
+// with inline comments
+type GitHubPublisher struct {
+	opts       GitHubOptions
+	httpClient *http.Client
+}
+
+// Summary reports what a publish run did
+type Summary struct {
+	Inline  int    // Suggestions posted as inline comments
+	Outside int    // Suggestions outside the diff, folded into the review body
+	URL     string // Web URL of the created review, when the API reports one
+}
+
+// NewGitHubPublisher validates the options and returns a publisher
+func NewGitHubPublisher(opts GitHubOptions) (*GitHubPublisher, error) {
+	if opts.Token == "" {
+		return nil, fmt.Errorf("no GitHub token - set GITHUB_TOKEN")
+	}
+	if opts.Repo == "" {
+		return nil, fmt.Errorf("no repository - pass --repo or add a GitHub origin remote")
+	}
+	if opts.PR <= 0 {
+		return nil, fmt.Errorf("no pull request number - pass --pr")
+	}
+	if opts.BaseURL == "" {
+		opts.BaseURL = "https://api.github.com"
+	}
+	return &GitHubPublisher{
+		opts:       opts,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// githubReviewComment is one inline comment in a PR review request
+type githubReviewComment struct {
+	Path      string `json:"path"`
+	Line      int    `json:"line"`
+	Side      string `json:"side"`
+	StartLine int    `json:"start_line,omitempty"`
+	StartSide string `json:"start_side,omitempty"`
+	Body      string `json:"body"`
+}
+
+// githubReviewRequest is the payload for creating a PR review
+type githubReviewRequest struct {
+	Body     string                `json:"body"`
+	Event    string                `json:"event"`
+	Comments []githubReviewComment `json:"comments,omitempty"`
+}
+
+// Publish creates a pull request review with one inline comment per
+// suggestion that falls inside the PR diff. Suggestions on unchanged lines
+// cannot carry inline comments, so they are summarized in the review body.
+func (p *GitHubPublisher) Publish(ctx context.Context, result *review.ReviewResult) (*Summary, error) {
+	changed, err := p.changedLines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []githubReviewComment
+	var outside []review.Suggestion
+	for _, s := range result.Suggestions {
+		if s.Line <= 0 || !changed.contains(s.File, s.Line) {
+			outside = append(outside, s)
+			continue
+		}
+		comment := githubReviewComment{
+			Path: s.File,
+			Line: s.Line,
+			Side: "RIGHT",
+			Body: inlineCommentBody(s),
+		}
+		if s.EndLine > s.Line && changed.contains(s.File, s.EndLine) {
+			comment.StartLine = s.Line
+			comment.StartSide = "RIGHT"
+			comment.Line = s.EndLine
+		}
+		comments = append(comments, comment)
+	}
+
+	payload := githubReviewRequest{
+		Body:     reviewBody(result, outside),
+		Event:    "COMMENT",
+		Comments: comments,
+	}
+
+	var created struct {
+		HTMLURL string `json:"html_url"`
+	}
+	path := fmt.Sprintf("/repos/%s/pulls/%d/reviews", p.opts.Repo, p.opts.PR)
+	if err := p.request(ctx, http.MethodPost, path, payload, &created); err != nil {
+		return nil, err
+	}
+
+	return &Summary{
+		Inline:  len(comments),
+		Outside: len(outside),
+		URL:     created.HTMLURL,
+	}, nil
+}
+
+// changedLines fetches the PR's file patches and returns the set of
+// right-side (new file) lines the diff touches, which is where GitHub
+// accepts inline review comments
+func (p *GitHubPublisher) changedLines(ctx context.Context) (lineSet, error) {
+	changed := lineSet{}
+	// The files endpoint pages at 100 entries
+	for page := 1; ; page++ {
+		var files []struct {
+			Filename string `json:"filename"`
+			Patch    string `json:"patch"`
+		}
+		path := fmt.Sprintf("/repos/%s/pulls/%d/files?per_page=100&page=%d", p.opts.Repo, p.opts.PR, page)
+		if err := p.request(ctx, http.MethodGet, path, nil, &files); err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			break
+		}
+		for _, f := range files {
+			changed.addPatch(f.Filename, f.Patch)
+		}
+		if len(files) < 100 {
+			break
+		}
+	}
+	return changed, nil
+}
+
+// request performs one API call, encoding body as JSON when non-nil and
+// decoding the response into out when non-nil
+func (p *GitHubPublisher) request(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.opts.BaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+p.opts.Token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitHub API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("GitHub API returned %d for %s: %s", resp.StatusCode, path, strings.TrimSpace(string(data)))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to parse GitHub API response: %w", err)
+		}
+	}
+	return nil
+}
+
+// inlineCommentBody renders one suggestion as a review comment, with a
+// GitHub suggested-change block when the fix replaces the commented lines
+func inlineCommentBody(s review.Suggestion) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("**%s** (%s)\n\n", s.Title, s.Severity))
+	if s.Description != "" {
+		sb.WriteString(s.Description + "\n")
+	}
+	if s.SuggestFix != "" && s.SuggestFix != "N/A" && !s.Unfixable {
+		sb.WriteString("\n```suggestion\n")
+		sb.WriteString(strings.TrimRight(s.SuggestFix, "\n"))
+		sb.WriteString("\n```\n")
+	}
+	return sb.String()
+}
+
+// reviewBody builds the top-level review comment: the run summary plus any
+// findings that could not be attached inline
+func reviewBody(result *review.ReviewResult, outside []review.Suggestion) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## PreReview: %d suggestion(s) across %d file(s)\n\n",
+		len(result.Suggestions), len(result.Files)))
+	if result.Summary != "" {
+		sb.WriteString(result.Summary + "\n\n")
+	}
+	if len(outside) > 0 {
+		sb.WriteString("### Findings outside the diff\n\n")
+		for _, s := range outside {
+			sb.WriteString(fmt.Sprintf("- **%s** (%s) — `%s:%d`", s.Title, s.Severity, s.File, s.Line))
+			if s.Description != "" {
+				sb.WriteString(": " + s.Description)
+			}
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// lineSet records which new-file lines each file's diff touches
+type lineSet map[string]map[int]bool
+
+func (ls lineSet) contains(file string, line int) bool {
+	return ls[file][line]
+}
+
+// addPatch parses a unified diff patch and records the added and context
+// lines of each hunk on the new side of the file
+func (ls lineSet) addPatch(file, patch string) {
+	if patch == "" {
+		return
+	}
+	lines := ls[file]
+	if lines == nil {
+		lines = make(map[int]bool)
+		ls[file] = lines
+	}
+
+	newLine := 0
+	for _, line := range strings.Split(patch, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			// Hunk header: @@ -a,b +c,d @@
+			newLine = parseHunkStart(line)
+		case strings.HasPrefix(line, "+"), strings.HasPrefix(line, " "):
+			// Added and context lines exist on the new side
+			if newLine > 0 {
+				lines[newLine] = true
+				newLine++
+			}
+		}
+	}
+}
+
+// parseHunkStart extracts the new-file start line from a hunk header,
+// returning 0 when the header does not parse
+func parseHunkStart(header string) int {
+	idx := strings.Index(header, "+")
+	if idx < 0 {
+		return 0
+	}
+	rest := header[idx+1:]
+	end := strings.IndexAny(rest, ", @")
+	if end < 0 {
+		return 0
+	}
+	var start int
+	if _, err := fmt.Sscanf(rest[:end], "%d", &start); err != nil {
+		return 0
+	}
+	return start
+}
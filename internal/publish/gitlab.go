@@ -0,0 +1,209 @@
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// GitLabOptions configures a publish run against the GitLab API
+type GitLabOptions struct {
+	Token   string // API token (GITLAB_TOKEN)
+	Project string // Project path, e.g. "group/repo", detected from origin when empty
+	MR      int    // Merge request IID
+	BaseURL string // API base, defaults to https://gitlab.com/api/v4
+}
+
+// GitLabPublisher posts a review result to a merge request as discussions
+// anchored to diff lines
+type GitLabPublisher struct {
+	opts       GitLabOptions
+	httpClient *http.Client
+}
+
+// NewGitLabPublisher validates the options and returns a publisher
+func NewGitLabPublisher(opts GitLabOptions) (*GitLabPublisher, error) {
+	if opts.Token == "" {
+		return nil, fmt.Errorf("no GitLab token - set GITLAB_TOKEN")
+	}
+	if opts.Project == "" {
+		return nil, fmt.Errorf("no project - pass --repo or add a GitLab origin remote")
+	}
+	if opts.MR <= 0 {
+		return nil, fmt.Errorf("no merge request number - pass --pr")
+	}
+	if opts.BaseURL == "" {
+		opts.BaseURL = "https://gitlab.com/api/v4"
+	}
+	return &GitLabPublisher{
+		opts:       opts,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// gitlabPosition anchors a discussion to a line on the new side of the
+// merge request diff
+type gitlabPosition struct {
+	BaseSHA      string `json:"base_sha"`
+	StartSHA     string `json:"start_sha"`
+	HeadSHA      string `json:"head_sha"`
+	PositionType string `json:"position_type"`
+	NewPath      string `json:"new_path"`
+	NewLine      int    `json:"new_line"`
+}
+
+// gitlabDiscussion is the payload for creating an MR discussion
+type gitlabDiscussion struct {
+	Body     string          `json:"body"`
+	Position *gitlabPosition `json:"position,omitempty"`
+}
+
+// Publish creates one diff-anchored discussion per suggestion inside the MR
+// diff, plus a summary discussion that also lists anything that could not
+// be placed on a changed line
+func (p *GitLabPublisher) Publish(ctx context.Context, result *review.ReviewResult) (*Summary, error) {
+	refs, changed, err := p.mergeRequestDiff(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var inline []review.Suggestion
+	var outside []review.Suggestion
+	for _, s := range result.Suggestions {
+		if s.Line > 0 && changed.contains(s.File, s.Line) {
+			inline = append(inline, s)
+		} else {
+			outside = append(outside, s)
+		}
+	}
+
+	base := fmt.Sprintf("/projects/%s/merge_requests/%d", url.PathEscape(p.opts.Project), p.opts.MR)
+
+	// Summary discussion first, so the inline comments have context
+	summaryPayload := gitlabDiscussion{Body: reviewBody(result, outside)}
+	if err := p.request(ctx, http.MethodPost, base+"/discussions", summaryPayload, nil); err != nil {
+		return nil, err
+	}
+
+	posted := 0
+	for _, s := range inline {
+		payload := gitlabDiscussion{
+			Body: gitlabCommentBody(s),
+			Position: &gitlabPosition{
+				BaseSHA:      refs.BaseSHA,
+				StartSHA:     refs.StartSHA,
+				HeadSHA:      refs.HeadSHA,
+				PositionType: "text",
+				NewPath:      s.File,
+				NewLine:      s.Line,
+			},
+		}
+		if err := p.request(ctx, http.MethodPost, base+"/discussions", payload, nil); err != nil {
+			return nil, fmt.Errorf("posted %d of %d inline discussion(s): %w", posted, len(inline), err)
+		}
+		posted++
+	}
+
+	return &Summary{Inline: posted, Outside: len(outside)}, nil
+}
+
+// gitlabDiffRefs are the commit SHAs a diff position must reference
+type gitlabDiffRefs struct {
+	BaseSHA  string `json:"base_sha"`
+	StartSHA string `json:"start_sha"`
+	HeadSHA  string `json:"head_sha"`
+}
+
+// mergeRequestDiff fetches the MR's diff refs and the set of new-side
+// lines its changes touch
+func (p *GitLabPublisher) mergeRequestDiff(ctx context.Context) (*gitlabDiffRefs, lineSet, error) {
+	var mr struct {
+		DiffRefs gitlabDiffRefs `json:"diff_refs"`
+		Changes  []struct {
+			NewPath string `json:"new_path"`
+			Diff    string `json:"diff"`
+		} `json:"changes"`
+	}
+	path := fmt.Sprintf("/projects/%s/merge_requests/%d/changes", url.PathEscape(p.opts.Project), p.opts.MR)
+	if err := p.request(ctx, http.MethodGet, path, nil, &mr); err != nil {
+		return nil, nil, err
+	}
+	if mr.DiffRefs.HeadSHA == "" {
+		return nil, nil, fmt.Errorf("merge request %d has no diff refs - is it open?", p.opts.MR)
+	}
+
+	changed := lineSet{}
+	for _, c := range mr.Changes {
+		changed.addPatch(c.NewPath, c.Diff)
+	}
+	return &mr.DiffRefs, changed, nil
+}
+
+// request performs one API call, encoding body as JSON when non-nil and
+// decoding the response into out when non-nil
+func (p *GitLabPublisher) request(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.opts.BaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("PRIVATE-TOKEN", p.opts.Token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitLab API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("GitLab API returned %d for %s: %s", resp.StatusCode, path, strings.TrimSpace(string(data)))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to parse GitLab API response: %w", err)
+		}
+	}
+	return nil
+}
+
+// gitlabCommentBody renders one suggestion as a discussion note, with a
+// GitLab suggestion block when the fix replaces the commented lines
+func gitlabCommentBody(s review.Suggestion) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("**%s** (%s)\n\n", s.Title, s.Severity))
+	if s.Description != "" {
+		sb.WriteString(s.Description + "\n")
+	}
+	if s.SuggestFix != "" && s.SuggestFix != "N/A" && !s.Unfixable {
+		// ```suggestion:-0+N replaces the commented line plus N lines below
+		span := 0
+		if s.EndLine > s.Line {
+			span = s.EndLine - s.Line
+		}
+		sb.WriteString(fmt.Sprintf("\n```suggestion:-0+%d\n", span))
+		sb.WriteString(strings.TrimRight(s.SuggestFix, "\n"))
+		sb.WriteString("\n```\n")
+	}
+	return sb.String()
+}
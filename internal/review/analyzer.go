@@ -0,0 +1,57 @@
+package review
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/analyzers"
+)
+
+// analyzerContext formats static-analyzer findings as prompt context so
+// the model can confirm, prioritize, and propose fixes for them
+func analyzerContext(findings []analyzers.Finding) string {
+	var sb strings.Builder
+	sb.WriteString("\n\nSTATIC ANALYZER FINDINGS (from running configured linters on this file):\n")
+	for _, f := range findings {
+		sb.WriteString(fmt.Sprintf("- line %d [%s/%s, %s]: %s\n", f.Line, f.Tool, f.Rule, f.Severity, f.Message))
+	}
+	sb.WriteString(`
+These findings come from deterministic tools and are reliable. Where relevant,
+incorporate them into your review with concrete fixes, and focus your own
+analysis on issues the linters cannot detect.
+`)
+	return sb.String()
+}
+
+// analyzerSuggestions converts analyzer findings into suggestions so they
+// appear in the review even if the model ignores them
+func analyzerSuggestions(findings []analyzers.Finding, file string) []Suggestion {
+	suggestions := make([]Suggestion, 0, len(findings))
+	for _, f := range findings {
+		suggestions = append(suggestions, Suggestion{
+			File:        file,
+			Line:        f.Line,
+			EndLine:     f.Line,
+			Severity:    analyzerSeverity(f.Severity),
+			Confidence:  ConfidenceHigh, // Deterministic tool output
+			Category:    CategoryBestPractice,
+			Title:       fmt.Sprintf("%s: %s", f.Rule, f.Message),
+			Description: fmt.Sprintf("Reported by %s (rule %s).", f.Tool, f.Rule),
+			Source:      f.Tool,
+			Provenance:  []string{f.Tool + ":" + f.Rule},
+		})
+	}
+	return suggestions
+}
+
+// analyzerSeverity maps analyzer severities onto review severities
+func analyzerSeverity(severity string) Severity {
+	switch strings.ToLower(severity) {
+	case "error":
+		return SeverityError
+	case "warning":
+		return SeverityWarning
+	default:
+		return SeverityInfo
+	}
+}
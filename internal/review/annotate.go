@@ -0,0 +1,150 @@
+package review
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// annotateMarker identifies comments written by `prereview annotate`, so
+// they can be found and stripped again later
+const annotateMarker = "PREREVIEW("
+
+// AnnotateResult reports what an annotate run changed
+type AnnotateResult struct {
+	FilesChanged int
+	Inserted     int
+	Removed      int
+}
+
+// AnnotateFiles inserts a TODO-style comment above each suggestion's line
+// (without applying any fixes), so findings can be addressed later in the
+// editor. Re-running is idempotent: existing annotations are not duplicated.
+func AnnotateFiles(suggestions []Suggestion) (*AnnotateResult, error) {
+	result := &AnnotateResult{}
+
+	byFile := make(map[string][]Suggestion)
+	for _, s := range suggestions {
+		if s.Line > 0 {
+			byFile[s.File] = append(byFile[s.File], s)
+		}
+	}
+
+	for file, fileSuggestions := range byFile {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return result, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		lines := strings.Split(string(data), "\n")
+
+		// Insert bottom-up so earlier insertions don't shift later lines
+		sort.Slice(fileSuggestions, func(i, j int) bool {
+			return fileSuggestions[i].Line > fileSuggestions[j].Line
+		})
+
+		inserted := 0
+		for _, s := range fileSuggestions {
+			idx := s.Line - 1
+			if idx < 0 || idx >= len(lines) {
+				continue
+			}
+			comment := annotationComment(file, lines[idx], s)
+			if idx > 0 && strings.TrimSpace(lines[idx-1]) == strings.TrimSpace(comment) {
+				continue // already annotated
+			}
+			lines = append(lines[:idx], append([]string{comment}, lines[idx:]...)...)
+			inserted++
+		}
+
+		if inserted == 0 {
+			continue
+		}
+		if err := os.WriteFile(file, []byte(strings.Join(lines, "\n")), 0644); err != nil {
+			return result, fmt.Errorf("failed to write %s: %w", file, err)
+		}
+		result.FilesChanged++
+		result.Inserted += inserted
+	}
+
+	return result, nil
+}
+
+// RemoveAnnotations strips previously inserted annotation comments from
+// the given files, ignoring files that no longer exist
+func RemoveAnnotations(files []string) (*AnnotateResult, error) {
+	result := &AnnotateResult{}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return result, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+
+		lines := strings.Split(string(data), "\n")
+		kept := lines[:0]
+		removed := 0
+		for _, line := range lines {
+			if isAnnotation(line) {
+				removed++
+				continue
+			}
+			kept = append(kept, line)
+		}
+
+		if removed == 0 {
+			continue
+		}
+		if err := os.WriteFile(file, []byte(strings.Join(kept, "\n")), 0644); err != nil {
+			return result, fmt.Errorf("failed to write %s: %w", file, err)
+		}
+		result.FilesChanged++
+		result.Removed += removed
+	}
+
+	return result, nil
+}
+
+// annotationComment builds the comment line for one suggestion, using the
+// file's comment syntax and the flagged line's indentation
+func annotationComment(file, flaggedLine string, s Suggestion) string {
+	indent := flaggedLine[:len(flaggedLine)-len(strings.TrimLeft(flaggedLine, " \t"))]
+	leader, trailer := commentSyntax(file)
+	text := fmt.Sprintf("%sPREREVIEW(%s): %s", leader, s.Severity, s.Title)
+	if trailer != "" {
+		text += " " + trailer
+	}
+	return indent + text
+}
+
+// isAnnotation reports whether a line is one of our annotation comments
+func isAnnotation(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	for _, leader := range []string{"// ", "# ", "-- ", "<!-- ", "/* "} {
+		if strings.HasPrefix(trimmed, leader+annotateMarker) {
+			return true
+		}
+	}
+	return false
+}
+
+// commentSyntax picks a line-comment leader (and optional trailer) for a
+// file based on its extension
+func commentSyntax(file string) (leader, trailer string) {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".py", ".rb", ".sh", ".bash", ".zsh", ".yml", ".yaml", ".toml", ".pl", ".r", ".ex", ".exs":
+		return "# ", ""
+	case ".sql", ".lua":
+		return "-- ", ""
+	case ".html", ".htm", ".xml", ".md", ".vue", ".svelte":
+		return "<!-- ", "-->"
+	case ".css", ".scss", ".less":
+		return "/* ", "*/"
+	default:
+		return "// ", ""
+	}
+}
@@ -0,0 +1,78 @@
+package review
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// baselineEntry is one known finding in a stored baseline. File and title
+// are kept alongside the fingerprint for debuggability.
+type baselineEntry struct {
+	Fingerprint string `json:"fingerprint"`
+	File        string `json:"file"`
+	Title       string `json:"title"`
+}
+
+// Fingerprint identifies a finding across runs. Line numbers are
+// deliberately excluded so unrelated edits shifting code around don't make
+// known findings look new.
+func Fingerprint(s Suggestion) string {
+	sum := sha1.Sum([]byte(s.File + "|" + s.Category + "|" + s.Title))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// SaveBaseline writes the result's findings as a baseline file, for CI to
+// store (artifact, notes) and ratchet future runs against
+func SaveBaseline(path string, result *ReviewResult) error {
+	entries := make([]baselineEntry, 0, len(result.Suggestions))
+	for _, s := range result.Suggestions {
+		entries = append(entries, baselineEntry{
+			Fingerprint: Fingerprint(s),
+			File:        s.File,
+			Title:       s.Title,
+		})
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode baseline: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write baseline: %w", err)
+	}
+	return nil
+}
+
+// LoadBaseline reads a stored baseline into a fingerprint set
+func LoadBaseline(path string) (map[string]bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var entries []baselineEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline: %w", err)
+	}
+
+	known := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		known[entry.Fingerprint] = true
+	}
+	return known, nil
+}
+
+// NewFindings returns only the suggestions not present in the baseline -
+// the findings this change introduced
+func NewFindings(suggestions []Suggestion, baseline map[string]bool) []Suggestion {
+	fresh := make([]Suggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		if !baseline[Fingerprint(s)] {
+			fresh = append(fresh, s)
+		}
+	}
+	return fresh
+}
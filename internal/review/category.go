@@ -0,0 +1,60 @@
+package review
+
+import "strings"
+
+// Canonical suggestion categories. Filters, stats, and output grouping all
+// key on these, so every free-form model category is normalized onto them.
+const (
+	CategorySecurity     = "security"
+	CategoryPerformance  = "performance"
+	CategoryStyle        = "style"
+	CategoryBug          = "bug"
+	CategoryBestPractice = "best-practice"
+)
+
+// categorySynonyms maps the free-form labels models actually return onto
+// the canonical taxonomy
+var categorySynonyms = map[string]string{
+	"sec":            CategorySecurity,
+	"vuln":           CategorySecurity,
+	"vulnerability":  CategorySecurity,
+	"security-issue": CategorySecurity,
+
+	"perf":         CategoryPerformance,
+	"optimization": CategoryPerformance,
+	"efficiency":   CategoryPerformance,
+
+	"styling":     CategoryStyle,
+	"formatting":  CategoryStyle,
+	"format":      CategoryStyle,
+	"lint":        CategoryStyle,
+	"readability": CategoryStyle,
+
+	"bugs":        CategoryBug,
+	"defect":      CategoryBug,
+	"error":       CategoryBug,
+	"logic":       CategoryBug,
+	"logic-error": CategoryBug,
+	"correctness": CategoryBug,
+
+	"best practice":   CategoryBestPractice,
+	"best-practices":  CategoryBestPractice,
+	"best_practice":   CategoryBestPractice,
+	"bestpractice":    CategoryBestPractice,
+	"convention":      CategoryBestPractice,
+	"maintainability": CategoryBestPractice,
+	"design":          CategoryBestPractice,
+	"code-quality":    CategoryBestPractice,
+	"quality":         CategoryBestPractice,
+}
+
+// NormalizeCategory maps a free-form category label onto the canonical
+// taxonomy. Unrecognized labels are kept, lowercased, so no information is
+// lost for genuinely novel categories.
+func NormalizeCategory(raw string) string {
+	category := strings.ToLower(strings.TrimSpace(raw))
+	if canonical, ok := categorySynonyms[category]; ok {
+		return canonical
+	}
+	return category
+}
@@ -0,0 +1,154 @@
+package review
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeepPass describes one focused pass of a deep review
+type DeepPass struct {
+	Name  string // e.g., "security"
+	Focus string // Pass-specific instructions for the model
+}
+
+// DeepPasses are the passes run by a deep review, in order
+var DeepPasses = []DeepPass{
+	{
+		Name: "security",
+		Focus: `This pass focuses EXCLUSIVELY on security:
+- Injection vulnerabilities (SQL, command, template, path traversal)
+- Authentication and authorization gaps
+- Secrets, credentials, or tokens in code
+- Unsafe deserialization, unvalidated input, SSRF
+- Cryptographic misuse (weak algorithms, hardcoded keys, bad randomness)
+Use CATEGORY: security for all findings in this pass.`,
+	},
+	{
+		Name: "performance",
+		Focus: `This pass focuses EXCLUSIVELY on performance:
+- Algorithmic complexity issues (nested loops over large data, repeated lookups)
+- Unnecessary allocations, copies, or I/O inside loops
+- Missing caching or memoization opportunities with measurable impact
+- N+1 query patterns and chatty external calls
+- Resource leaks (unclosed handles, unbounded growth)
+Use CATEGORY: performance for all findings in this pass.`,
+	},
+	{
+		Name: "maintainability",
+		Focus: `This pass focuses EXCLUSIVELY on maintainability:
+- Error handling gaps that will make failures hard to diagnose
+- Confusing control flow, deeply nested conditionals, dead code
+- Fragile assumptions that will break under change (magic numbers, implicit ordering)
+- Missing or misleading naming that obscures intent
+- Duplicated logic that should be consolidated
+Use CATEGORY: best-practice for all findings in this pass.`,
+	},
+}
+
+// DeepReview performs an intensive multi-pass review of a single file.
+// Unlike Review, it sends the full file content (no diff) and runs one
+// focused pass per area, merging all findings into a single result.
+func (r *Reviewer) DeepReview(ctx context.Context, path string, content string) (*ReviewResult, error) {
+	result := &ReviewResult{
+		Files: []string{path},
+	}
+
+	for i, pass := range DeepPasses {
+		fmt.Printf("  [%d/%d] %s pass on %s...\n", i+1, len(DeepPasses), pass.Name, path)
+
+		prompt := buildDeepReviewPrompt(path, content, pass, r.standardsContext, r.projectHints)
+
+		response, err := r.chat(ctx, prompt)
+		if err != nil {
+			// Show error to user but continue with remaining passes
+			fmt.Printf("    ✗ Error: %v\n", err)
+			continue
+		}
+
+		suggestions, err := parseReviewResponse(response, path)
+		if err != nil {
+			fmt.Printf("    ✗ Error: %v\n", err)
+			continue
+		}
+
+		// Ensure findings carry the pass's category and provenance
+		for j := range suggestions {
+			if suggestions[j].Category == "" {
+				suggestions[j].Category = pass.Name
+			}
+			suggestions[j].Provenance = appendProvenance(suggestions[j].Provenance, "pass:"+pass.Name)
+		}
+
+		if len(suggestions) > 0 {
+			fmt.Printf("    ✓ Found %d suggestion(s)\n", len(suggestions))
+		}
+
+		result.Suggestions = append(result.Suggestions, suggestions...)
+	}
+
+	// Collapse findings reported by multiple passes at the same location
+	result.Suggestions = MergeByLocation(result.Suggestions)
+
+	return result, nil
+}
+
+// buildDeepReviewPrompt creates the prompt for a single deep review pass
+func buildDeepReviewPrompt(path string, content string, pass DeepPass, standardsContext string, projectHints []string) string {
+	basePrompt := `You are performing an INTENSIVE, focused code review of a single file.
+The developer explicitly requested a thorough once-over before modifying this file,
+so be more exhaustive than a normal pre-commit review - but still avoid false positives.
+
+` + pass.Focus + `
+
+For each GENUINE issue found, respond in this exact format:
+---
+LINE: <line number where issue starts>
+END_LINE: <end line number if multi-line, otherwise same as LINE>
+SEVERITY: <error|warning|info|hint>
+CONFIDENCE: <high|medium|low>
+CATEGORY: <security|performance|style|bug|best-practice>
+TITLE: <short title>
+DESCRIPTION: <detailed description explaining WHY this is an issue and the RISK if not fixed>
+ORIGINAL:
+<<<
+the exact original code lines copied verbatim from the file
+include multiple lines if needed, preserving all whitespace and indentation
+>>>
+FIX:
+<<<
+the exact replacement code
+include multiple lines if needed, preserving all whitespace and indentation
+>>>
+---
+
+CRITICAL RULES:
+1. ORIGINAL must be copied EXACTLY from the file content - character for character
+2. Include enough context (2-3 lines before/after) to make the match unique
+3. Preserve ALL whitespace, tabs, and indentation exactly as they appear
+4. If no code fix is applicable, use: N/A (without <<< >>>)
+5. Only report issues relevant to this pass's focus area
+`
+
+	// Add coding standards context if available
+	if standardsContext != "" {
+		basePrompt += standardsContext
+	}
+
+	// Add project-specific hints if provided
+	if len(projectHints) > 0 {
+		basePrompt += "\n\nPROJECT-SPECIFIC CONTEXT (trust these hints from the developer):\n"
+		for _, hint := range projectHints {
+			basePrompt += "- " + hint + "\n"
+		}
+	}
+
+	basePrompt += `
+If no issues are found for this pass, respond with: NO_ISSUES
+
+File: ` + path + `
+
+Full file content:
+` + content
+
+	return basePrompt
+}
@@ -0,0 +1,168 @@
+package review
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// secretArgPattern matches ARG/ENV names that look like credentials
+var secretArgPattern = regexp.MustCompile(`(?i)^(ARG|ENV)\s+\w*(PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|CREDENTIAL|PRIVATE_?KEY)\w*`)
+
+// isDockerfile reports whether a changed file is a Dockerfile
+func isDockerfile(path string) bool {
+	base := strings.ToLower(filepath.Base(path))
+	return base == "dockerfile" || base == "containerfile" ||
+		strings.HasPrefix(base, "dockerfile.") || strings.HasSuffix(base, ".dockerfile")
+}
+
+// dockerfileChecks runs built-in best-practice checks on a Dockerfile and
+// returns deterministic findings to merge into the review
+func dockerfileChecks(change git.FileChange) []Suggestion {
+	var suggestions []Suggestion
+
+	lines := splitLines(change.Content)
+	sawUser := false
+	lastUserRoot := false
+
+	// Track logical instructions: continuation lines (trailing backslash)
+	// belong to the instruction that started them
+	instructionStart := 0
+	var instruction strings.Builder
+
+	flush := func(endLine int) {
+		text := instruction.String()
+		instruction.Reset()
+		if text == "" {
+			return
+		}
+
+		trimmed := strings.TrimSpace(text)
+		upper := strings.ToUpper(trimmed)
+		line := instructionStart + 1
+
+		switch {
+		case strings.HasPrefix(upper, "FROM "):
+			image := strings.Fields(trimmed)[1]
+			if !strings.Contains(image, ":") && !strings.Contains(image, "@") {
+				suggestions = append(suggestions, Suggestion{
+					File:        change.Path,
+					Line:        line,
+					EndLine:     line,
+					Severity:    SeverityWarning,
+					Confidence:  ConfidenceHigh,
+					Category:    "best-practice",
+					Title:       fmt.Sprintf("Unpinned base image '%s'", image),
+					Description: "The base image has no tag, so builds will silently pick up new versions. Pin a specific tag or digest for reproducible builds.",
+					Source:      "dockerfile-check",
+					Provenance:  []string{"dockerfile-check"},
+				})
+			} else if strings.HasSuffix(image, ":latest") {
+				suggestions = append(suggestions, Suggestion{
+					File:        change.Path,
+					Line:        line,
+					EndLine:     line,
+					Severity:    SeverityWarning,
+					Confidence:  ConfidenceHigh,
+					Category:    "best-practice",
+					Title:       fmt.Sprintf("Base image '%s' uses the latest tag", image),
+					Description: "The 'latest' tag is mutable, so builds are not reproducible. Pin a specific version tag or digest.",
+					Source:      "dockerfile-check",
+					Provenance:  []string{"dockerfile-check"},
+				})
+			}
+
+		case strings.HasPrefix(upper, "RUN "):
+			if strings.Contains(trimmed, "apt-get install") &&
+				!strings.Contains(trimmed, "rm -rf /var/lib/apt/lists") {
+				suggestions = append(suggestions, Suggestion{
+					File:        change.Path,
+					Line:        line,
+					EndLine:     endLine + 1,
+					Severity:    SeverityWarning,
+					Confidence:  ConfidenceHigh,
+					Category:    "best-practice",
+					Title:       "apt-get install without cleaning the package cache",
+					Description: "Package lists left in the layer bloat the image. Append '&& rm -rf /var/lib/apt/lists/*' to the same RUN instruction.",
+					Source:      "dockerfile-check",
+					Provenance:  []string{"dockerfile-check"},
+				})
+			}
+
+		case strings.HasPrefix(upper, "USER "):
+			sawUser = true
+			lastUserRoot = strings.TrimSpace(trimmed[5:]) == "root"
+
+		case secretArgPattern.MatchString(trimmed):
+			suggestions = append(suggestions, Suggestion{
+				File:        change.Path,
+				Line:        line,
+				EndLine:     line,
+				Severity:    SeverityError,
+				Confidence:  ConfidenceHigh,
+				Category:    "security",
+				Title:       "Possible secret passed via build argument or environment variable",
+				Description: "Build args and ENV values are baked into image layers and visible via 'docker history'. Use BuildKit secret mounts (--mount=type=secret) or runtime injection instead.",
+				Source:      "dockerfile-check",
+				Provenance:  []string{"dockerfile-check"},
+			})
+		}
+	}
+
+	for i, raw := range lines {
+		trimmed := strings.TrimSpace(raw)
+		if instruction.Len() == 0 {
+			if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+				continue
+			}
+			instructionStart = i
+		}
+		instruction.WriteString(strings.TrimSuffix(trimmed, "\\"))
+		instruction.WriteString(" ")
+		if !strings.HasSuffix(trimmed, "\\") {
+			flush(i)
+		}
+	}
+	flush(len(lines) - 1)
+
+	// A Dockerfile with no USER (or ending as root) runs the container as root
+	if !sawUser || lastUserRoot {
+		suggestions = append(suggestions, Suggestion{
+			File:        change.Path,
+			Line:        1,
+			EndLine:     1,
+			Severity:    SeverityWarning,
+			Confidence:  ConfidenceMedium,
+			Category:    "security",
+			Title:       "Container runs as root",
+			Description: "No non-root USER instruction is in effect at the end of the Dockerfile. Add a dedicated user and switch to it so a container escape has less impact.",
+			Source:      "dockerfile-check",
+			Provenance:  []string{"dockerfile-check"},
+		})
+	}
+
+	return suggestions
+}
+
+// dockerfileContext formats built-in Dockerfile findings as prompt context
+// so the model can add context-aware remediation instead of re-deriving them
+func dockerfileContext(findings []Suggestion) string {
+	if len(findings) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n\nDOCKERFILE CHECK FINDINGS (from built-in deterministic checks):\n")
+	for _, f := range findings {
+		sb.WriteString(fmt.Sprintf("- line %d [%s]: %s\n", f.Line, f.Severity, f.Title))
+	}
+	sb.WriteString(`
+These findings are reliable. Where relevant, provide concrete remediation for them
+in your review, and focus your own analysis on issues these checks cannot detect
+(layer ordering, multi-stage build opportunities, misconfigured entrypoints).
+`)
+	return sb.String()
+}
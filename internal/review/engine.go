@@ -0,0 +1,21 @@
+package review
+
+import (
+	"context"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// Engine reviews a set of file changes and produces a result. The
+// Copilot-backed Reviewer is the default implementation; alternative
+// engines (static-analysis aggregators, remote review services, test
+// fakes) can be plugged in wherever an Engine is accepted.
+type Engine interface {
+	// Review performs a code review on the given file changes
+	Review(ctx context.Context, changes []git.FileChange) (*ReviewResult, error)
+	// Close releases any resources held by the engine
+	Close()
+}
+
+// Reviewer is the Copilot-backed Engine implementation
+var _ Engine = (*Reviewer)(nil)
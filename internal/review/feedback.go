@@ -0,0 +1,137 @@
+package review
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// feedbackFile holds one JSON record per line under the prereview data
+// directory: the team's votes on whether findings were valid
+const feedbackFile = "feedback.jsonl"
+
+// FeedbackRecord is one vote on a finding
+type FeedbackRecord struct {
+	Time     time.Time `json:"time"`
+	ID       string    `json:"id"` // Suggestion ID, for correlating votes
+	File     string    `json:"file"`
+	Category string    `json:"category"`
+	Title    string    `json:"title"`
+	Useful   bool      `json:"useful"` // false = judged a false positive
+}
+
+// NewFeedbackRecord captures a vote on a suggestion
+func NewFeedbackRecord(sug Suggestion, useful bool) FeedbackRecord {
+	return FeedbackRecord{
+		Time:     time.Now(),
+		ID:       SuggestionID(sug),
+		File:     sug.File,
+		Category: sug.Category,
+		Title:    sug.Title,
+		Useful:   useful,
+	}
+}
+
+// AppendFeedback records one vote under dir
+func AppendFeedback(dir string, record FeedbackRecord) error {
+	f, err := os.OpenFile(filepath.Join(dir, feedbackFile), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to record feedback: %w", err)
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(record)
+}
+
+// LoadFeedback reads all recorded votes under dir; a repository without
+// feedback yields no records, not an error. Malformed lines are skipped.
+func LoadFeedback(dir string) ([]FeedbackRecord, error) {
+	f, err := os.Open(filepath.Join(dir, feedbackFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read feedback: %w", err)
+	}
+	defer f.Close()
+
+	var records []FeedbackRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record FeedbackRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err == nil {
+			records = append(records, record)
+		}
+	}
+	return records, scanner.Err()
+}
+
+// feedbackHintVotes is the minimum votes a category needs before feedback
+// starts shaping the prompt, and feedbackHintShare the false-positive
+// share it takes
+const (
+	feedbackHintVotes = 3
+	feedbackHintShare = 2.0 / 3.0
+)
+
+// FeedbackHints derives prompt hints from aggregated feedback: categories
+// the team keeps voting down become "considered acceptable here" notes so
+// the model stops re-flagging them
+func FeedbackHints(records []FeedbackRecord) []string {
+	votes := make(map[string]int)
+	rejected := make(map[string]int)
+	for _, record := range records {
+		if record.Category == "" {
+			continue
+		}
+		votes[record.Category]++
+		if !record.Useful {
+			rejected[record.Category]++
+		}
+	}
+
+	categories := make([]string, 0, len(votes))
+	for category, total := range votes {
+		if total >= feedbackHintVotes && float64(rejected[category])/float64(total) >= feedbackHintShare {
+			categories = append(categories, category)
+		}
+	}
+	sort.Strings(categories)
+
+	hints := make([]string, 0, len(categories))
+	for _, category := range categories {
+		hints = append(hints, fmt.Sprintf(
+			"This team has judged most %s findings here acceptable - only flag clear-cut %s issues.",
+			category, category))
+	}
+	return hints
+}
+
+// SuggestedTolerance auto-tunes the review tolerance from the overall
+// false-positive rate: a team rejecting most findings gets a relaxed
+// review, a team accepting nearly everything a strict one. Returns "" when
+// there aren't enough votes (or nothing should change).
+func SuggestedTolerance(records []FeedbackRecord) string {
+	const minVotes = 10
+	if len(records) < minVotes {
+		return ""
+	}
+	rejected := 0
+	for _, record := range records {
+		if !record.Useful {
+			rejected++
+		}
+	}
+	rate := float64(rejected) / float64(len(records))
+	switch {
+	case rate >= 0.6:
+		return "relaxed"
+	case rate <= 0.1:
+		return "strict"
+	default:
+		return ""
+	}
+}
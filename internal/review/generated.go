@@ -0,0 +1,99 @@
+package review
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// generatedMarkers are comment phrases that identify generated files,
+// checked against the first few lines of content
+var generatedMarkers = []string{
+	"Code generated by",
+	"DO NOT EDIT",
+	"@generated",
+	"generated by the protocol buffer compiler",
+	"Generated by OpenAPI Generator",
+	"Autogenerated by Thrift Compiler",
+}
+
+// isGeneratedFile reports whether a change is generated tool output
+// (protoc, openapi-generator, etc.) rather than hand-written code
+func isGeneratedFile(change git.FileChange) bool {
+	// Path conventions first - cheap and reliable
+	base := strings.ToLower(filepath.Base(change.Path))
+	if strings.HasSuffix(base, ".pb.go") || strings.HasSuffix(base, "_pb2.py") ||
+		strings.HasSuffix(base, ".pb.cc") || strings.HasSuffix(base, ".pb.h") ||
+		strings.HasSuffix(base, "_grpc.py") {
+		return true
+	}
+
+	// Generator markers appear in the file header; the last SplitN element
+	// holds the whole remainder, so drop it to keep the scan to the actual
+	// first lines - a file merely mentioning a marker is not generated
+	lines := strings.SplitN(change.Content, "\n", 10)
+	if len(lines) == 10 {
+		lines = lines[:9]
+	}
+	header := strings.Join(lines, "\n")
+	for _, marker := range generatedMarkers {
+		if strings.Contains(header, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// generatedAdvice produces a single suggestion for a generated file instead
+// of a line-level review: verify the generator's source spec changed
+// consistently and advise regenerating rather than hand-editing
+func generatedAdvice(change git.FileChange, changedPaths map[string]bool) Suggestion {
+	specChanged := false
+	for path := range changedPaths {
+		if isGeneratorSpec(path) {
+			specChanged = true
+			break
+		}
+	}
+
+	sug := Suggestion{
+		File:       change.Path,
+		Line:       1,
+		Category:   "generated-code",
+		Confidence: ConfidenceHigh,
+		Source:     "generated",
+		Provenance: []string{"rule:generated-code"},
+	}
+
+	if specChanged {
+		sug.Severity = SeverityInfo
+		sug.Title = "Generated file changed alongside its spec"
+		sug.Description = "This file is generator output and its source spec is also part of this change. " +
+			"Verify the output was produced by re-running the generator against the updated spec, " +
+			"not by hand-editing - hand edits are lost on the next regeneration."
+	} else {
+		sug.Severity = SeverityWarning
+		sug.Title = "Generated file changed without its source spec"
+		sug.Description = "This file is generator output, but no spec or schema file (.proto, openapi.yaml, " +
+			"generator config) is part of this change. Hand-edits to generated code are lost on the next " +
+			"regeneration - change the spec and regenerate instead."
+	}
+
+	return sug
+}
+
+// isGeneratorSpec reports whether a path looks like a generator's source
+// spec or configuration
+func isGeneratorSpec(path string) bool {
+	base := strings.ToLower(filepath.Base(path))
+	if strings.HasSuffix(base, ".proto") || strings.HasSuffix(base, ".thrift") {
+		return true
+	}
+	for _, name := range []string{"openapi", "swagger", "openapi-generator", ".openapi-generator"} {
+		if strings.HasPrefix(base, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package review
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// historyDirName is the legacy subdirectory of the prereview data
+// directory where runs were kept as one JSON file each, before the move
+// to history.db; old records there are still read
+const historyDirName = "history"
+
+// HistoryRecord is a compact summary of one review run
+type HistoryRecord struct {
+	Time     time.Time        `json:"time"`
+	Files    int              `json:"files"`
+	Fixed    int              `json:"fixed"`
+	Skipped  int              `json:"skipped"`
+	Duration float64          `json:"duration_seconds,omitempty"` // Review time in seconds
+	Tokens   int              `json:"tokens,omitempty"`           // Prompt + completion tokens
+	Findings []HistoryFinding `json:"findings"`
+}
+
+// HistoryFinding keeps just enough of a suggestion for aggregation
+type HistoryFinding struct {
+	File     string `json:"file"`
+	Severity string `json:"severity"`
+	Category string `json:"category"`
+	Title    string `json:"title"`
+}
+
+// NewHistoryRecord summarizes a review result into a history record
+func NewHistoryRecord(result *ReviewResult, fixed, skipped int) HistoryRecord {
+	record := HistoryRecord{
+		Time:    time.Now(),
+		Files:   len(result.Files),
+		Fixed:   fixed,
+		Skipped: skipped,
+		Tokens:  result.Usage.TotalTokens(),
+	}
+	for _, fs := range result.FileStats {
+		record.Duration += fs.Seconds
+	}
+	for _, s := range result.Suggestions {
+		record.Findings = append(record.Findings, HistoryFinding{
+			File:     s.File,
+			Severity: string(s.Severity),
+			Category: s.Category,
+			Title:    s.Title,
+		})
+	}
+	return record
+}
+
+// AppendHistory records one run into the history database under dir
+func AppendHistory(dir string, record HistoryRecord) error {
+	return appendHistoryDB(dir, record)
+}
+
+// LoadHistory reads all records under dir newer than since, combining the
+// history database with any legacy JSON records from before the SQLite
+// migration. A repository with no history yields no records, not an error.
+func LoadHistory(dir string, since time.Time) ([]HistoryRecord, error) {
+	records, err := loadHistoryDB(dir, since)
+	if err != nil {
+		return nil, err
+	}
+
+	legacy, err := loadLegacyHistory(dir, since)
+	if err != nil {
+		return nil, err
+	}
+	return append(records, legacy...), nil
+}
+
+// loadLegacyHistory reads pre-SQLite records from the JSON history
+// directory; a missing directory yields no records
+func loadLegacyHistory(dir string, since time.Time) ([]HistoryRecord, error) {
+	entries, err := os.ReadDir(filepath.Join(dir, historyDirName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	var records []HistoryRecord
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, historyDirName, entry.Name()))
+		if err != nil {
+			continue
+		}
+		var record HistoryRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			continue
+		}
+		if record.Time.Before(since) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
@@ -0,0 +1,149 @@
+package review
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	_ "modernc.org/sqlite" // registers the cgo-free "sqlite" driver
+)
+
+// historyDBFile is the SQLite database holding one row per review run,
+// under the prereview data directory
+const historyDBFile = "history.db"
+
+// historySchema creates the run and finding tables on first open
+const historySchema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id               INTEGER PRIMARY KEY AUTOINCREMENT,
+	time             INTEGER NOT NULL, -- unix nanoseconds
+	files            INTEGER NOT NULL,
+	fixed            INTEGER NOT NULL,
+	skipped          INTEGER NOT NULL,
+	duration_seconds REAL    NOT NULL DEFAULT 0,
+	tokens           INTEGER NOT NULL DEFAULT 0
+);
+CREATE TABLE IF NOT EXISTS findings (
+	run_id   INTEGER NOT NULL REFERENCES runs(id),
+	file     TEXT NOT NULL,
+	severity TEXT NOT NULL,
+	category TEXT NOT NULL,
+	title    TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_runs_time ON runs(time);
+CREATE INDEX IF NOT EXISTS idx_findings_run ON findings(run_id);
+`
+
+// openHistoryDB opens (and if needed initializes) the history database
+func openHistoryDB(dir string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", filepath.Join(dir, historyDBFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history database: %w", err)
+	}
+	if _, err := db.Exec(historySchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize history database: %w", err)
+	}
+	return db, nil
+}
+
+// appendHistoryDB inserts one run with its findings
+func appendHistoryDB(dir string, record HistoryRecord) error {
+	db, err := openHistoryDB(dir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to record run: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		`INSERT INTO runs (time, files, fixed, skipped, duration_seconds, tokens) VALUES (?, ?, ?, ?, ?, ?)`,
+		record.Time.UnixNano(), record.Files, record.Fixed, record.Skipped, record.Duration, record.Tokens,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record run: %w", err)
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to record run: %w", err)
+	}
+
+	for _, f := range record.Findings {
+		if _, err := tx.Exec(
+			`INSERT INTO findings (run_id, file, severity, category, title) VALUES (?, ?, ?, ?, ?)`,
+			runID, f.File, f.Severity, f.Category, f.Title,
+		); err != nil {
+			return fmt.Errorf("failed to record finding: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// loadHistoryDB reads all runs newer than since, oldest first
+func loadHistoryDB(dir string, since time.Time) ([]HistoryRecord, error) {
+	db, err := openHistoryDB(dir)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query(
+		`SELECT id, time, files, fixed, skipped, duration_seconds, tokens FROM runs WHERE time >= ? ORDER BY time`,
+		since.UnixNano(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	defer rows.Close()
+
+	var records []HistoryRecord
+	var ids []int64
+	for rows.Next() {
+		var id, nanos int64
+		var record HistoryRecord
+		if err := rows.Scan(&id, &nanos, &record.Files, &record.Fixed, &record.Skipped, &record.Duration, &record.Tokens); err != nil {
+			return nil, fmt.Errorf("failed to read history: %w", err)
+		}
+		record.Time = time.Unix(0, nanos)
+		records = append(records, record)
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+
+	for i, id := range ids {
+		findings, err := loadFindings(db, id)
+		if err != nil {
+			return nil, err
+		}
+		records[i].Findings = findings
+	}
+	return records, nil
+}
+
+// loadFindings reads the findings attached to one run
+func loadFindings(db *sql.DB, runID int64) ([]HistoryFinding, error) {
+	rows, err := db.Query(`SELECT file, severity, category, title FROM findings WHERE run_id = ?`, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history findings: %w", err)
+	}
+	defer rows.Close()
+
+	var findings []HistoryFinding
+	for rows.Next() {
+		var f HistoryFinding
+		if err := rows.Scan(&f.File, &f.Severity, &f.Category, &f.Title); err != nil {
+			return nil, fmt.Errorf("failed to read history findings: %w", err)
+		}
+		findings = append(findings, f)
+	}
+	return findings, rows.Err()
+}
@@ -0,0 +1,87 @@
+package review
+
+import (
+	"fmt"
+)
+
+// severityRank orders severities for merging (higher wins)
+func severityRank(s Severity) int {
+	switch s {
+	case SeverityError:
+		return 3
+	case SeverityWarning:
+		return 2
+	case SeverityInfo:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// confidenceRank orders confidences for merging (higher wins)
+func confidenceRank(c Confidence) int {
+	switch c {
+	case ConfidenceHigh:
+		return 3
+	case ConfidenceMedium:
+		return 2
+	case ConfidenceLow:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MergeByLocation merges suggestions that target the same file and line
+// into a single suggestion. The first-seen suggestion's text wins, the
+// highest severity and confidence are kept, and the provenance lists are
+// combined so users can see which pass/model/rule contributed the finding.
+func MergeByLocation(suggestions []Suggestion) []Suggestion {
+	merged := make([]Suggestion, 0, len(suggestions))
+	index := make(map[string]int) // file:line -> index in merged
+
+	for _, s := range suggestions {
+		key := fmt.Sprintf("%s:%d", s.File, s.Line)
+
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(merged)
+			merged = append(merged, s)
+			continue
+		}
+
+		existing := &merged[i]
+		if severityRank(s.Severity) > severityRank(existing.Severity) {
+			existing.Severity = s.Severity
+		}
+		if confidenceRank(s.Confidence) > confidenceRank(existing.Confidence) {
+			existing.Confidence = s.Confidence
+		}
+		// Adopt a fix if the existing finding has none
+		if existing.SuggestFix == "" && s.SuggestFix != "" {
+			existing.SuggestFix = s.SuggestFix
+			existing.OriginalCode = s.OriginalCode
+			existing.Unfixable = s.Unfixable
+		}
+		existing.Provenance = appendProvenance(existing.Provenance, s.Provenance...)
+	}
+
+	return merged
+}
+
+// appendProvenance appends entries, skipping duplicates
+func appendProvenance(provenance []string, entries ...string) []string {
+	for _, entry := range entries {
+		exists := false
+		for _, have := range provenance {
+			if have == entry {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			provenance = append(provenance, entry)
+		}
+	}
+	return provenance
+}
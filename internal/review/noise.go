@@ -0,0 +1,33 @@
+package review
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// noisePatterns match enormous machine-generated regions that carry no
+// review signal but burn through the token budget
+var noisePatterns = []*regexp.Regexp{
+	// Inline data URIs (SVG, fonts, images)
+	regexp.MustCompile(`data:[\w/+.-]+;base64,[A-Za-z0-9+/=]{200,}`),
+	// Inline sourcemaps
+	regexp.MustCompile(`(?:\/\/[#@] sourceMappingURL=)\S{200,}`),
+	// Bare base64 blobs (embedded certificates, serialized payloads)
+	regexp.MustCompile(`[A-Za-z0-9+/]{400,}={0,2}`),
+	// Long hex blobs (compiled bytecode, binary dumps)
+	regexp.MustCompile(`(?:\\x[0-9a-fA-F]{2}){100,}|[0-9a-fA-F]{512,}`),
+}
+
+// elideNoise replaces machine-generated regions (base64 blobs, sourcemaps,
+// data URIs) with placeholders noting what was removed, so they never reach
+// the model. Returns the cleaned content and how many regions were elided.
+func elideNoise(content string) (string, int) {
+	elided := 0
+	for _, pattern := range noisePatterns {
+		content = pattern.ReplaceAllStringFunc(content, func(match string) string {
+			elided++
+			return fmt.Sprintf("[ELIDED: %d chars of machine-generated data]", len(match))
+		})
+	}
+	return content, elided
+}
@@ -0,0 +1,76 @@
+package review
+
+import (
+	"fmt"
+
+	"github.com/emilushi/prereview/internal/analyzers"
+	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/secrets"
+)
+
+// ReviewOffline runs only the deterministic parts of a review - secret
+// scanning, shellcheck/Dockerfile rules, and configured static analyzers -
+// without contacting a provider. It backs the --offline degraded mode used
+// when the Copilot CLI (or another provider) cannot start, so a commit
+// still gets a safety net instead of failing outright.
+func (r *Reviewer) ReviewOffline(changes []git.FileChange) (*ReviewResult, error) {
+	result := &ReviewResult{
+		Files: make([]string, 0, len(changes)),
+	}
+
+	for i, change := range changes {
+		result.Files = append(result.Files, change.Path)
+
+		if change.IsBinary {
+			continue
+		}
+		if err := change.EnsureLoaded(); err != nil {
+			return nil, fmt.Errorf("failed to load %s: %w", change.Path, err)
+		}
+
+		fmt.Printf("  [%d/%d] Scanning %s...\n", i+1, len(changes), change.Path)
+
+		// Secret scanning still blocks: credentials must not be committed
+		// just because the AI is unreachable
+		for _, finding := range secrets.Scan(change.Content) {
+			result.Suggestions = append(result.Suggestions, secretSuggestion(change.Path, finding))
+		}
+
+		// Deterministic per-language checks
+		if isShellScript(change) {
+			findings := runShellcheck(change.Content)
+			result.Suggestions = append(result.Suggestions, shellcheckSuggestions(findings, change.Path)...)
+		} else if isDockerfile(change.Path) {
+			result.Suggestions = append(result.Suggestions, dockerfileChecks(change)...)
+		}
+
+		// Configured static analyzers
+		if findings := analyzers.Run(r.analyzers, change.Path); len(findings) > 0 {
+			result.Suggestions = append(result.Suggestions, analyzerSuggestions(findings, change.Path)...)
+		}
+
+		change.Release()
+	}
+
+	result.Summary = "Offline degraded review: only deterministic checks ran (secret scanning, " +
+		"shellcheck/Dockerfile rules, configured analyzers). No AI review was performed - " +
+		"re-run with a working provider for full coverage."
+
+	return result, nil
+}
+
+// OfflineChecklist lists the manual review steps the AI would normally
+// cover, shown alongside degraded-mode findings. Standards detected in the
+// repository are called out so the reviewer knows what to check against.
+func (r *Reviewer) OfflineChecklist() []string {
+	checklist := []string{
+		"Logic: do the changes do what the commit message says?",
+		"Error handling: are failures surfaced rather than swallowed?",
+		"Security: any user input reaching commands, queries, or file paths?",
+		"Tests: do new code paths have coverage?",
+	}
+	if r.standardsContext != "" {
+		checklist = append(checklist, "Standards: this repository declares coding standards - check the change against them")
+	}
+	return checklist
+}
@@ -0,0 +1,43 @@
+package review
+
+// securityProfilePrompt replaces the generic review focus when the
+// security profile is active. It is intentionally independent of the
+// tolerance setting - a security pass should not relax itself.
+const securityProfilePrompt = `
+REVIEW PROFILE: SECURITY
+You are performing a SECURITY-FOCUSED review. Evaluate the changes against the
+OWASP Top 10 and common vulnerability classes:
+- A01 Broken Access Control: missing authorization checks, IDOR, path traversal
+- A02 Cryptographic Failures: weak algorithms, hardcoded keys, bad randomness, plaintext storage
+- A03 Injection: SQL, NoSQL, command, LDAP, XPath, template, header injection
+- A04 Insecure Design: missing rate limits, trust-boundary violations
+- A05 Security Misconfiguration: permissive CORS, debug endpoints, default credentials
+- A06 Vulnerable Components: dangerous use of known-risky APIs (eval, pickle, yaml.load)
+- A07 Authentication Failures: weak session handling, credential exposure, missing MFA hooks
+- A08 Data Integrity Failures: unsafe deserialization, unsigned updates
+- A09 Logging Failures: secrets in logs, missing audit events for sensitive operations
+- A10 SSRF: user-controlled URLs reaching internal services
+
+SECRET HANDLING:
+- Flag any credentials, tokens, API keys, or private keys appearing in code or config
+- Flag secrets passed via command lines, URLs, or logged output
+
+Report ONLY security-relevant findings in this profile. Use CATEGORY: security
+for every finding. Be thorough - in this profile, missing a real vulnerability
+is worse than a false positive.
+`
+
+// applyProfile adjusts parsed suggestions according to the active profile.
+// The security profile raises every security finding to blocking severity.
+func (r *Reviewer) applyProfile(suggestions []Suggestion) []Suggestion {
+	if r.profile != "security" {
+		return suggestions
+	}
+
+	for i := range suggestions {
+		if suggestions[i].Category == "security" {
+			suggestions[i].Severity = SeverityError
+		}
+	}
+	return suggestions
+}
@@ -0,0 +1,78 @@
+package review
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProgressEvent describes the state of a review run after a file has been
+// processed, for progress reporting in long non-interactive runs
+type ProgressEvent struct {
+	Done    int           // Files processed so far, including skipped ones
+	Total   int           // Total files in this pass
+	Path    string        // File just processed
+	Elapsed time.Duration // Time since the pass started
+	ETA     time.Duration // Estimated remaining time from average per-file latency
+}
+
+// ProgressFunc receives a ProgressEvent after each file is processed
+type ProgressFunc func(ProgressEvent)
+
+// ProgressDisplay renders per-file review progress. The default prints
+// plain indented lines; interactive terminal runs install a richer
+// implementation (spinner and progress bar) from the ui package.
+type ProgressDisplay interface {
+	StartFile(index, total int, path string) // A file's review is starting
+	Note(text string)                        // Mid-file remark (skips, redactions, errors)
+	Streaming(status string)                 // Live receiving status for the in-flight request, "" clears it
+	FinishFile(findings int)                 // The file's review completed
+	Finish()                                 // The pass is over
+}
+
+// plainDisplay is the default display: one indented line per file, safe
+// for logs and non-terminals
+type plainDisplay struct{}
+
+func (plainDisplay) StartFile(index, total int, path string) {
+	fmt.Printf("  [%d/%d] Reviewing %s...\n", index, total, path)
+}
+
+func (plainDisplay) Note(text string) {
+	fmt.Println("    " + text)
+}
+
+func (plainDisplay) Streaming(status string) {
+	if status == "" {
+		fmt.Printf("\r%-60s\r", "")
+		return
+	}
+	fmt.Printf("\r%-60s", "    "+status)
+}
+
+func (plainDisplay) FinishFile(findings int) {
+	if findings > 0 {
+		fmt.Printf("    ✓ Found %d suggestion(s)\n", findings)
+	}
+}
+
+func (plainDisplay) Finish() {}
+
+// reportProgress invokes the configured progress callback, estimating the
+// remaining time from the average per-file latency so far
+func (r *Reviewer) reportProgress(start time.Time, done, total int, path string) {
+	if r.progress == nil {
+		return
+	}
+	elapsed := time.Since(start)
+	var eta time.Duration
+	if done > 0 && done < total {
+		eta = elapsed / time.Duration(done) * time.Duration(total-done)
+	}
+	r.progress(ProgressEvent{
+		Done:    done,
+		Total:   total,
+		Path:    path,
+		Elapsed: elapsed,
+		ETA:     eta,
+	})
+}
@@ -0,0 +1,57 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// perSummaryDiffTokens caps how much diff each file contributes to the
+// PR summary prompt
+const perSummaryDiffTokens = 2000
+
+// SummarizeChanges asks the model for a PR-description-ready markdown
+// summary of the changeset: what changed, risk areas, and where reviewers
+// should focus. It produces prose only, no finding-level output.
+func (r *Reviewer) SummarizeChanges(ctx context.Context, changes []git.FileChange) (string, error) {
+	var sb strings.Builder
+	sb.WriteString(`Write a pull request description for the following changeset.
+Respond in markdown with exactly these sections:
+
+## Summary
+2-4 sentences describing what the change does and why, written for a
+reviewer who has not seen the code.
+
+## Risk areas
+Bullet list of the parts most likely to break or regress, or "None
+identified" when the change is low risk.
+
+## Review focus
+Bullet list of the files or behaviors a reviewer should look at first.
+
+Do not report line-level findings, do not invent context beyond the diff,
+and do not include any heading other than the three above.
+`)
+
+	if r.commitMessage != "" {
+		sb.WriteString("\nIntended commit message:\n" + r.commitMessage + "\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("\nChangeset: %d file(s)\n", len(changes)))
+	for _, change := range changes {
+		if err := change.EnsureLoaded(); err != nil {
+			continue
+		}
+		sb.WriteString("\nFile: " + change.Path + " (" + change.Status + ")\n")
+		sb.WriteString("Diff:\n" + truncateToTokens(change.Diff, perSummaryDiffTokens) + "\n")
+		change.Release()
+	}
+
+	summary, err := r.chat(ctx, sb.String())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(summary), nil
+}
@@ -0,0 +1,202 @@
+package review
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// maxRelatedContext caps how much related-file context is added to a prompt,
+// so neighboring signatures never crowd out the file under review
+const maxRelatedContext = 4000
+
+// relatedContext gathers signatures from files related to the one under
+// review (locally imported Go packages, C/C++ headers) so the model stops
+// flagging "undefined symbol" false positives for code defined elsewhere.
+// Returns an empty string when nothing related is found.
+func (r *Reviewer) relatedContext(change git.FileChange) string {
+	if !r.includeRelated || r.repoRoot == "" {
+		return ""
+	}
+
+	var sections []string
+	switch strings.ToLower(filepath.Ext(change.Path)) {
+	case ".go":
+		sections = goRelatedSignatures(r.repoRoot, change)
+	case ".c", ".cc", ".cpp", ".m":
+		sections = headerSignatures(r.repoRoot, change.Path)
+	}
+
+	if len(sections) == 0 {
+		return ""
+	}
+
+	context := "\n\nRELATED CODE (signatures from files this change depends on - symbols below are DEFINED, do not flag them as undefined):\n"
+	for _, section := range sections {
+		if len(context)+len(section) > maxRelatedContext {
+			break
+		}
+		context += section
+	}
+	return context
+}
+
+// goRelatedSignatures extracts exported declarations from local packages
+// imported by the changed file
+func goRelatedSignatures(repoRoot string, change git.FileChange) []string {
+	modulePath := readModulePath(repoRoot)
+	if modulePath == "" {
+		return nil
+	}
+
+	var sections []string
+	for _, imp := range parseGoImports(change.Content) {
+		if !strings.HasPrefix(imp, modulePath+"/") {
+			continue
+		}
+
+		dir := filepath.Join(repoRoot, strings.TrimPrefix(imp, modulePath+"/"))
+		signatures := exportedGoSignatures(dir)
+		if len(signatures) == 0 {
+			continue
+		}
+
+		sections = append(sections, "\nPackage "+imp+":\n"+strings.Join(signatures, "\n")+"\n")
+	}
+	return sections
+}
+
+// readModulePath returns the module path declared in the repo's go.mod,
+// or an empty string when no manifest exists
+func readModulePath(repoRoot string) string {
+	data, err := os.ReadFile(filepath.Join(repoRoot, "go.mod"))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "module ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "module "))
+		}
+	}
+	return ""
+}
+
+// parseGoImports returns the import paths declared in a Go source file
+func parseGoImports(content string) []string {
+	var imports []string
+	inBlock := false
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if inBlock {
+			if trimmed == ")" {
+				inBlock = false
+				continue
+			}
+			if path := quotedImportPath(trimmed); path != "" {
+				imports = append(imports, path)
+			}
+			continue
+		}
+
+		if trimmed == "import (" {
+			inBlock = true
+			continue
+		}
+		if strings.HasPrefix(trimmed, "import ") {
+			if path := quotedImportPath(strings.TrimPrefix(trimmed, "import ")); path != "" {
+				imports = append(imports, path)
+			}
+		}
+	}
+	return imports
+}
+
+// quotedImportPath extracts the quoted path from an import line, handling
+// aliased imports ("alias \"path\"")
+func quotedImportPath(line string) string {
+	start := strings.Index(line, `"`)
+	if start < 0 {
+		return ""
+	}
+	end := strings.Index(line[start+1:], `"`)
+	if end < 0 {
+		return ""
+	}
+	return line[start+1 : start+1+end]
+}
+
+// exportedGoSignatures scans a package directory and returns its exported
+// top-level declaration lines (func/type signatures, without bodies)
+func exportedGoSignatures(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var signatures []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+
+		for _, line := range strings.Split(string(data), "\n") {
+			if !isExportedDeclaration(line) {
+				continue
+			}
+			// Keep the signature only, not the body
+			if idx := strings.Index(line, " {"); idx >= 0 {
+				line = line[:idx]
+			}
+			signatures = append(signatures, line)
+		}
+	}
+	return signatures
+}
+
+// isExportedDeclaration reports whether a source line opens an exported
+// top-level func or type declaration
+func isExportedDeclaration(line string) bool {
+	switch {
+	case strings.HasPrefix(line, "func "):
+		rest := strings.TrimPrefix(line, "func ")
+		// Methods: skip the receiver before checking the name
+		if strings.HasPrefix(rest, "(") {
+			if idx := strings.Index(rest, ") "); idx >= 0 {
+				rest = rest[idx+2:]
+			}
+		}
+		return len(rest) > 0 && rest[0] >= 'A' && rest[0] <= 'Z'
+	case strings.HasPrefix(line, "type "):
+		rest := strings.TrimPrefix(line, "type ")
+		return len(rest) > 0 && rest[0] >= 'A' && rest[0] <= 'Z'
+	}
+	return false
+}
+
+// headerSignatures returns the contents of the header file matching a
+// C/C++/Objective-C source file, when one exists next to it
+func headerSignatures(repoRoot, path string) []string {
+	base := strings.TrimSuffix(path, filepath.Ext(path))
+	for _, ext := range []string{".h", ".hpp"} {
+		data, err := os.ReadFile(filepath.Join(repoRoot, base+ext))
+		if err != nil {
+			continue
+		}
+		content := string(data)
+		if len(content) > maxRelatedContext {
+			content = content[:maxRelatedContext]
+		}
+		return []string{"\nHeader " + base + ext + ":\n" + content + "\n"}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package review
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// isRenameOnly reports whether a change is a pure move with no content
+// edits: git scored it 100% similar, or its diff carries no hunks
+func isRenameOnly(change git.FileChange) bool {
+	if change.Status != "R" {
+		return false
+	}
+	if change.Similarity == 100 {
+		return true
+	}
+	return change.Diff != "" && !strings.Contains(change.Diff, "\n@@")
+}
+
+// renameOnlyAdvice records that a file was moved without content changes,
+// so the review shows it was considered without spending an AI call on it
+func renameOnlyAdvice(change git.FileChange) Suggestion {
+	return Suggestion{
+		File:        change.Path,
+		Severity:    SeverityInfo,
+		Confidence:  ConfidenceHigh,
+		Title:       "Rename-only change",
+		Description: fmt.Sprintf("Moved from %s with no content changes; nothing to review.", change.OldPath),
+		Category:    "rename-only",
+		Source:      "rename",
+		Provenance:  []string{"rule:rename-only"},
+	}
+}
+
+// renameContext tells the model a renamed file's diff already isolates the
+// content edits, so it doesn't review the move itself
+func renameContext(change git.FileChange) string {
+	if change.Status != "R" || change.OldPath == "" {
+		return ""
+	}
+	return fmt.Sprintf(`
+
+NOTE: This file was renamed from %s to %s. The diff above pairs the old and
+new content, so it shows only the actual edits made on top of the move.
+Review ONLY those content changes - do not comment on the rename itself or
+treat unchanged moved lines as new code.`, change.OldPath, change.Path)
+}
@@ -1,12 +1,18 @@
 package review
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
-	"github.com/emilushi/prereview/internal/copilot"
+	"github.com/emilushi/prereview/internal/analyzers"
+	"github.com/emilushi/prereview/internal/coverage"
 	"github.com/emilushi/prereview/internal/git"
+	"github.com/emilushi/prereview/internal/llm"
+	"github.com/emilushi/prereview/internal/secrets"
 	"github.com/emilushi/prereview/internal/standards"
 )
 
@@ -19,9 +25,12 @@ type Suggestion struct {
 	Confidence   Confidence // How confident the AI is about this suggestion
 	Title        string
 	Description  string
-	OriginalCode string // Original code to be replaced
-	SuggestFix   string // Suggested replacement code
-	Category     string // security, performance, style, etc.
+	OriginalCode string   // Original code to be replaced
+	SuggestFix   string   // Suggested replacement code
+	Category     string   // security, performance, style, etc.
+	Unfixable    bool     // OriginalCode could not be located in the staged content
+	Source       string   // Where the finding came from: "" (AI), "shellcheck", etc.
+	Provenance   []string // Which passes/models/rules contributed this finding
 }
 
 // Confidence levels for suggestions
@@ -48,49 +57,216 @@ type ReviewResult struct {
 	Files       []string
 	Suggestions []Suggestion
 	Summary     string
+	Coverage    map[string]float64 // Per-file coverage percentage, if a report was found
+	Usage       Usage              // Token counts and request total for the run
+	FileStats   []FileStat         // Per-file cost breakdown (findings, time, tokens)
 }
 
+// FileStat is one file's share of a review run, used by the statistics
+// block to surface which files cost the most time and tokens
+type FileStat struct {
+	File     string
+	Findings int
+	Seconds  float64
+	Tokens   int
+}
+
+// ChatClient is the provider surface the Reviewer consumes. It aliases
+// llm.Provider so wrappers, mocks, and the record/replay transport can be
+// injected interchangeably.
+type ChatClient = llm.Provider
+
 // Reviewer handles code review using AI
 type Reviewer struct {
-	client           *copilot.Client
+	client           ChatClient
 	model            string
 	standardsContext string
-	projectHints     []string // User-provided hints about the project
-	tolerance        string   // strict, moderate, relaxed
+	projectHints     []string           // User-provided hints about the project
+	tolerance        string             // strict, moderate, relaxed
+	profile          string             // Review profile: "" (general) or "security"
+	maxPromptTokens  int                // Prompt token budget, 0 = unlimited
+	repoRoot         string             // Repository root, used for related-file lookups
+	includeRelated   bool               // Include signatures from related files in prompts
+	commitMessage    string             // Prepared commit message, used as intent context
+	summarizeOver    int                // Shallow-pass threshold in estimated tokens, 0 = never
+	suggestTests     bool               // Nudge about new functions without test changes
+	language         string             // Language for titles/descriptions, "" = English
+	analyzers        []string           // Enabled static analyzers for the pre-pass
+	coverage         *coverage.Report   // Optional coverage report detected at the repo root
+	promptTemplate   *template.Template // Optional custom prompt template from .prereview/prompt.tmpl
+	progress         ProgressFunc       // Optional per-file progress callback for CI runs
+	display          ProgressDisplay    // Per-file progress rendering, plain lines by default
+	requestTimeout   time.Duration      // Per-request timeout, 0 = unlimited
+	verbose          bool               // Log prompt sizes and request timings to stderr
+	usage            Usage              // Token accounting for the current run
+}
+
+// Options configures a Reviewer
+type Options struct {
+	Model             string           // AI model to use; empty lets the SDK pick the default
+	RepoRoot          string           // Repository root for standards/coverage detection
+	CustomStandards   []string         // Additional standards files from config
+	ProjectHints      []string         // Project-specific hints for the AI
+	Tolerance         string           // strict, moderate, relaxed (default: moderate)
+	Profile           string           // Review profile: "" (general) or "security"
+	MaxPromptTokens   int              // Prompt token budget, 0 = unlimited
+	IncludeRelated    bool             // Include signatures from related files in prompts
+	CommitMessage     string           // Prepared commit message, used as intent context
+	SummarizeOver     int              // Switch to a shallow summarized pass above this many estimated tokens, 0 = never
+	SuggestTests      bool             // Nudge about new functions without test changes
+	Language          string           // Language for titles/descriptions (ISO code or name), "" = English
+	Analyzers         []string         // Static analyzers to run as a pre-pass (eslint, golangci-lint, phpcs)
+	Provider          string           // Chat backend: "copilot" (default) or "openai"
+	BaseURL           string           // OpenAI-compatible endpoint base URL
+	APIKey            string           // API key for HTTP providers
+	LocalParallel     int              // Local providers: max concurrent generations
+	LocalContext      int              // Local providers: prompt budget in estimated tokens
+	PersistentSession bool             // Copilot: reuse one session across files
+	RequestTimeout    time.Duration    // Per-request timeout, 0 = unlimited
+	RetryAttempts     int              // Retries after a transient provider failure
+	RetryBackoff      time.Duration    // Initial retry delay, doubled per retry
+	NoCache           bool             // Disable the on-disk response cache
+	CacheTTL          time.Duration    // How long cached responses stay valid, 0 = forever
+	MaxInFlight       int              // Max concurrent provider requests, 0 = unlimited
+	RequestsPerMinute int              // Provider requests per rolling minute, 0 = unlimited
+	ExtraCABundle     string           // Extra PEM roots for TLS-intercepting proxies
+	Providers         []string         // Ordered provider failover list; overrides Provider when set
+	QueueNotify       func(queued int) // Called when a request queues behind the rate limiter
+	Verbose           bool             // Log prompt sizes and request timings to stderr
+	Progress          ProgressFunc
+	Display           ProgressDisplay // Per-file progress rendering, nil = plain lines
 }
 
 // NewReviewer creates a new Reviewer instance
-func NewReviewer(model string, repoRoot string, customStandards []string, projectHints []string, tolerance string) (*Reviewer, error) {
-	client, err := copilot.NewClient()
+func NewReviewer(opts Options) (*Reviewer, error) {
+	reviewer, err := newReviewer(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Identical prompts (the common re-review flow) are served from the
+	// on-disk response cache unless caching is disabled
+	cacheDir := ""
+	if !opts.NoCache {
+		cacheDir = llm.DefaultCacheDir()
+	}
+
+	client, err := llm.New(llm.Options{
+		Provider:          opts.Provider,
+		BaseURL:           opts.BaseURL,
+		APIKey:            opts.APIKey,
+		MaxParallel:       opts.LocalParallel,
+		MaxContext:        opts.LocalContext,
+		PersistentSession: opts.PersistentSession,
+		RetryAttempts:     opts.RetryAttempts,
+		RetryBackoff:      opts.RetryBackoff,
+		CacheDir:          cacheDir,
+		CacheTTL:          opts.CacheTTL,
+		MaxInFlight:       opts.MaxInFlight,
+		RequestsPerMinute: opts.RequestsPerMinute,
+		QueueNotify:       opts.QueueNotify,
+		ExtraCABundle:     opts.ExtraCABundle,
+		Providers:         opts.Providers,
+	})
 	if err != nil {
 		return nil, err
 	}
+	reviewer.client = client
+
+	return reviewer, nil
+}
 
+// NewReviewerWithClient creates a Reviewer backed by the given client
+// instead of the configured provider - the injection point for mocks and
+// the record/replay harness
+func NewReviewerWithClient(opts Options, client ChatClient) (*Reviewer, error) {
+	reviewer, err := newReviewer(opts)
+	if err != nil {
+		return nil, err
+	}
+	reviewer.client = client
+	return reviewer, nil
+}
+
+// NewPromptPreviewer creates a Reviewer that can only build prompts, never
+// contacting a provider - used by `prereview prompt preview`
+func NewPromptPreviewer(opts Options) (*Reviewer, error) {
+	return newReviewer(opts)
+}
+
+// newReviewer builds a Reviewer without a provider attached
+func newReviewer(opts Options) (*Reviewer, error) {
 	// If no model specified, leave empty to let Copilot SDK pick the default
 	// This may provide better model selection similar to VS Code's "auto" mode
 
 	// Detect coding standards
 	var standardsContext string
-	if repoRoot != "" {
-		detector := standards.NewStandardsDetector(repoRoot, customStandards)
+	if opts.RepoRoot != "" {
+		detector := standards.NewStandardsDetector(opts.RepoRoot, opts.CustomStandards)
 		standardsContext = detector.GetStandardsContext()
 	}
 
+	// Detect a coverage report (coverage.out, lcov.info, coverage.xml)
+	var coverageReport *coverage.Report
+	if opts.RepoRoot != "" {
+		coverageReport = coverage.Detect(opts.RepoRoot)
+	}
+
+	// Load a custom prompt template if the repo provides one
+	promptTemplate, err := loadPromptTemplate(opts.RepoRoot)
+	if err != nil {
+		return nil, err
+	}
+
 	// Default tolerance
+	tolerance := opts.Tolerance
 	if tolerance == "" {
 		tolerance = "moderate"
 	}
 
 	return &Reviewer{
-		client:           client,
-		model:            model,
+		model:            opts.Model,
 		standardsContext: standardsContext,
-		projectHints:     projectHints,
+		projectHints:     opts.ProjectHints,
 		tolerance:        tolerance,
+		profile:          opts.Profile,
+		maxPromptTokens:  opts.MaxPromptTokens,
+		repoRoot:         opts.RepoRoot,
+		includeRelated:   opts.IncludeRelated,
+		commitMessage:    opts.CommitMessage,
+		summarizeOver:    opts.SummarizeOver,
+		suggestTests:     opts.SuggestTests,
+		language:         opts.Language,
+		analyzers:        opts.Analyzers,
+		coverage:         coverageReport,
+		promptTemplate:   promptTemplate,
+		progress:         opts.Progress,
+		display:          display(opts),
+		requestTimeout:   opts.RequestTimeout,
+		verbose:          opts.Verbose,
 	}, nil
 }
 
-// Close stops the Copilot client
+// display picks the configured progress display, defaulting to plain lines
+func display(opts Options) ProgressDisplay {
+	if opts.Display != nil {
+		return opts.Display
+	}
+	return plainDisplay{}
+}
+
+// Warmup verifies the provider is reachable and authenticated, so a run
+// fails fast with a clear message before any diff gathering instead of
+// erroring on the first file half a minute in. Providers without a health
+// check pass trivially.
+func (r *Reviewer) Warmup(ctx context.Context) error {
+	if checker, ok := r.client.(llm.HealthChecker); ok {
+		return checker.Ping(ctx)
+	}
+	return nil
+}
+
+// Close stops the underlying provider
 func (r *Reviewer) Close() {
 	if r.client != nil {
 		r.client.Close()
@@ -98,53 +274,543 @@ func (r *Reviewer) Close() {
 }
 
 // Review performs a code review on the given file changes
-func (r *Reviewer) Review(changes []git.FileChange) (*ReviewResult, error) {
+func (r *Reviewer) Review(ctx context.Context, changes []git.FileChange) (*ReviewResult, error) {
+	// Huge changesets get a shallow, budget-aware pass instead of
+	// line-level review of every file
+	if r.summarizeOver > 0 && totalEstimatedTokens(changes) > r.summarizeOver {
+		return r.reviewSummarized(ctx, changes)
+	}
+
+	r.usage = Usage{}
 	result := &ReviewResult{
 		Files: make([]string, 0, len(changes)),
 	}
 
+	// Set of all changed paths, used to cross-check generated files
+	// against their source specs
+	changedPaths := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		changedPaths[change.Path] = true
+	}
+
+	start := time.Now()
 	for i, change := range changes {
+		// Stop promptly when the review is cancelled
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		// Report the previous file as done before starting the next
+		if i > 0 {
+			r.reportProgress(start, i, len(changes), changes[i-1].Path)
+		}
+
 		result.Files = append(result.Files, change.Path)
 
+		// Record coverage for reviewed files if a report was detected
+		if r.coverage != nil {
+			if pct, ok := r.coverage.FileCoverage(change.Path); ok {
+				if result.Coverage == nil {
+					result.Coverage = make(map[string]float64)
+				}
+				result.Coverage[change.Path] = pct
+			}
+		}
+
 		if change.IsBinary {
 			continue
 		}
 
 		// Show progress
-		fmt.Printf("  [%d/%d] Reviewing %s...\n", i+1, len(changes), change.Path)
+		r.display.StartFile(i+1, len(changes), change.Path)
+
+		// Fetch content lazily so only the file under review is in memory
+		if err := change.EnsureLoaded(); err != nil {
+			r.display.Note(fmt.Sprintf("✗ Error: %v", err))
+			continue
+		}
+
+		// Pure moves have no content edits to review - tag and move on
+		// without spending an AI call
+		if isRenameOnly(change) {
+			r.display.Note(fmt.Sprintf("⏭ Rename-only (from %s) - nothing to review", change.OldPath))
+			result.Suggestions = append(result.Suggestions, renameOnlyAdvice(change))
+			change.Release()
+			continue
+		}
+
+		// Generated tool output gets regenerate advice instead of a
+		// line-level review - hand-edits there are lost anyway
+		if isGeneratedFile(change) {
+			r.display.Note("⏭ Generated file - advising regeneration instead of line review")
+			result.Suggestions = append(result.Suggestions, generatedAdvice(change, changedPaths))
+			change.Release()
+			continue
+		}
+
+		// Scan for secrets before anything is sent to the model: obvious
+		// credentials block the commit, and matches are redacted from the
+		// prompt so they never leave the machine
+		if findings := secrets.Scan(change.Content); len(findings) > 0 {
+			for _, finding := range findings {
+				result.Suggestions = append(result.Suggestions, secretSuggestion(change.Path, finding))
+			}
+			change.Content, _ = secrets.Redact(change.Content)
+			change.Diff, _ = secrets.Redact(change.Diff)
+			r.display.Note(fmt.Sprintf("⚠ %d potential secret(s) detected - redacted from the prompt", len(findings)))
+		}
+
+		// Elide machine-generated regions (base64 blobs, sourcemaps, data
+		// URIs) so they don't burn through the token budget
+		var elidedContent, elidedDiff int
+		change.Content, elidedContent = elideNoise(change.Content)
+		change.Diff, elidedDiff = elideNoise(change.Diff)
+		if elidedContent+elidedDiff > 0 {
+			r.display.Note(fmt.Sprintf("⚠ Elided %d machine-generated region(s) from the prompt", elidedContent+elidedDiff))
+		}
+
+		// Nudge about new functions landing without test changes
+		if r.suggestTests {
+			if nudge := testNudge(change, changedPaths); nudge != nil {
+				result.Suggestions = append(result.Suggestions, *nudge)
+			}
+		}
 
 		// Request review from Copilot
-		suggestions, err := r.reviewFile(change)
+		fileStart := time.Now()
+		tokensBefore := r.usage.TotalTokens()
+		suggestions, err := r.reviewFile(ctx, change)
+
+		// Drop the loaded content before moving to the next file
+		change.Release()
+
 		if err != nil {
 			// Show error to user but continue with other files
-			fmt.Printf("    ✗ Error: %v\n", err)
+			r.display.Note(fmt.Sprintf("✗ Error: %v", err))
 			continue
 		}
 
-		if len(suggestions) > 0 {
-			fmt.Printf("    ✓ Found %d suggestion(s)\n", len(suggestions))
-		}
+		result.FileStats = append(result.FileStats, FileStat{
+			File:     change.Path,
+			Findings: len(suggestions),
+			Seconds:  time.Since(fileStart).Seconds(),
+			Tokens:   r.usage.TotalTokens() - tokensBefore,
+		})
+
+		r.display.FinishFile(len(suggestions))
 
 		result.Suggestions = append(result.Suggestions, suggestions...)
 	}
+	r.display.Finish()
+
+	if len(changes) > 0 {
+		r.reportProgress(start, len(changes), len(changes), changes[len(changes)-1].Path)
+	}
+
+	// Produce a short overall assessment of the changeset
+	if len(result.Suggestions) > 0 {
+		result.Summary = r.summarize(ctx, result)
+	}
+
+	// Note any provider failovers so the user knows which backend actually
+	// produced the findings
+	if reporter, ok := r.client.(llm.FailoverReporter); ok {
+		for _, note := range reporter.FailoverNotes() {
+			result.Summary = strings.TrimSpace(result.Summary + "\n" + note)
+		}
+	}
 
+	result.Usage = r.usage
 	return result, nil
 }
 
+// summarize asks the model for a short project-level assessment of the
+// review result. Failures are non-fatal; an empty summary is returned.
+func (r *Reviewer) summarize(ctx context.Context, result *ReviewResult) string {
+	var sb strings.Builder
+	sb.WriteString(`You just completed a code review. Write a SHORT overall assessment (3-5 sentences, plain text, no markdown) covering:
+- Overall risk level of this changeset (low/medium/high) and why
+- The main themes across the findings
+- Whether the changes should be split into separate commits, and how
+
+Files reviewed:
+`)
+	for _, file := range result.Files {
+		sb.WriteString("- " + file + "\n")
+	}
+
+	sb.WriteString("\nFindings:\n")
+	for _, s := range result.Suggestions {
+		sb.WriteString(fmt.Sprintf("- [%s/%s] %s (%s:%d): %s\n",
+			s.Severity, s.Confidence, s.Title, s.File, s.Line, s.Description))
+	}
+
+	sb.WriteString(r.languageContext())
+
+	summary, err := r.chat(ctx, sb.String())
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(summary)
+}
+
+// secretSuggestion converts a secret-scanner finding into a blocking
+// suggestion. Severity is error with high confidence so hook mode refuses
+// to commit obvious credentials.
+func secretSuggestion(path string, finding secrets.Finding) Suggestion {
+	return Suggestion{
+		File:       path,
+		Line:       finding.Line,
+		Severity:   SeverityError,
+		Confidence: ConfidenceHigh,
+		Category:   CategorySecurity,
+		Title:      fmt.Sprintf("Possible %s committed", finding.Rule),
+		Description: fmt.Sprintf("Line %d contains what looks like a %s (%s). Committing credentials exposes them "+
+			"in history permanently - move the value to an environment variable or secret store and rotate it. "+
+			"The value was redacted before any content was sent to the AI provider.",
+			finding.Line, finding.Rule, finding.Preview),
+		Source:     "secrets",
+		Provenance: []string{"rule:secrets"},
+	}
+}
+
+// Explain answers a follow-up question about a suggestion, giving the model
+// the finding's full context so the answer stays grounded in the review
+func (r *Reviewer) Explain(sug Suggestion, question string) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("You reported the following code review finding:\n\n")
+	sb.WriteString(fmt.Sprintf("File: %s (lines %d-%d)\n", sug.File, sug.Line, sug.EndLine))
+	sb.WriteString(fmt.Sprintf("Severity: %s, Confidence: %s, Category: %s\n", sug.Severity, sug.Confidence, sug.Category))
+	sb.WriteString("Title: " + sug.Title + "\n")
+	sb.WriteString("Description: " + sug.Description + "\n")
+	if sug.OriginalCode != "" {
+		sb.WriteString("\nOriginal code:\n" + sug.OriginalCode + "\n")
+	}
+	if sug.SuggestFix != "" {
+		sb.WriteString("\nSuggested fix:\n" + sug.SuggestFix + "\n")
+	}
+	sb.WriteString("\nThe developer has a follow-up question about this finding. Answer it directly and concisely, in plain text:\n\n")
+	sb.WriteString(question)
+	sb.WriteString(r.languageContext())
+
+	return r.chat(context.Background(), sb.String())
+}
+
+// commitDiffBudget caps how much of the staged diff goes into the commit
+// message request; the summary only needs the shape of the change
+const commitDiffBudget = 48000
+
+// CommitMessage drafts a commit message from the final staged diff, for
+// `prereview commit`
+func (r *Reviewer) CommitMessage(diff string) (string, error) {
+	if len(diff) > commitDiffBudget {
+		diff = diff[:commitDiffBudget] + "\n... (diff truncated)"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Write a commit message for the following staged diff.\n")
+	sb.WriteString("Use a concise imperative subject line under 72 characters; add a short body only when the change needs explanation. ")
+	sb.WriteString("Respond with the commit message only - no markdown fences, no surrounding quotes.\n\n")
+	sb.WriteString(diff)
+	sb.WriteString(r.languageContext())
+
+	message, err := r.chat(context.Background(), sb.String())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(message), nil
+}
+
+// assemblePrompt builds the complete prompt for a file change - base
+// prompt plus related-file, intent, language, and deterministic-tool
+// context - and returns the deterministic suggestions gathered on the way
+func (r *Reviewer) assemblePrompt(change git.FileChange) (string, []Suggestion, error) {
+	prompt, err := r.buildPrompt(change)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// For renames with edits, steer the model to the content changes only
+	prompt += renameContext(change)
+
+	// Include signatures from files this change depends on, so symbols
+	// defined elsewhere aren't flagged as undefined
+	prompt += r.relatedContext(change)
+
+	// Include the prepared commit message as intent context, so mismatches
+	// between stated intent and actual changes can be flagged
+	prompt += r.intentContext()
+
+	// Ask for findings in the team's working language, if configured
+	prompt += r.languageContext()
+
+	// Run deterministic checks (shellcheck, Dockerfile rules) and feed
+	// their findings to the model for context-aware remediation
+	var deterministic []Suggestion
+	if isShellScript(change) {
+		findings := runShellcheck(change.Content)
+		prompt += shellcheckContext(findings)
+		deterministic = shellcheckSuggestions(findings, change.Path)
+	} else if isDockerfile(change.Path) {
+		deterministic = dockerfileChecks(change)
+		prompt += dockerfileContext(deterministic)
+	}
+
+	// Run any configured static analyzers and feed their findings to the
+	// model for confirmation, prioritization, and concrete fixes
+	if findings := analyzers.Run(r.analyzers, change.Path); len(findings) > 0 {
+		prompt += analyzerContext(findings)
+		deterministic = append(deterministic, analyzerSuggestions(findings, change.Path)...)
+	}
+
+	return prompt, deterministic, nil
+}
+
+// PreviewPrompt returns the exact prompt that would be sent to the
+// provider for a change, without contacting it. The same redaction and
+// noise elision the review loop applies happen here, so the preview
+// matches what actually leaves the machine.
+func (r *Reviewer) PreviewPrompt(change git.FileChange) (string, error) {
+	if err := change.EnsureLoaded(); err != nil {
+		return "", err
+	}
+	change.Content, _ = secrets.Redact(change.Content)
+	change.Diff, _ = secrets.Redact(change.Diff)
+	change.Content, _ = elideNoise(change.Content)
+	change.Diff, _ = elideNoise(change.Diff)
+	prompt, _, err := r.assemblePrompt(change)
+	return prompt, err
+}
+
 // reviewFile reviews a single file and returns suggestions
-func (r *Reviewer) reviewFile(change git.FileChange) ([]Suggestion, error) {
-	prompt := buildReviewPrompt(change, r.standardsContext, r.projectHints, r.tolerance)
+func (r *Reviewer) reviewFile(ctx context.Context, change git.FileChange) ([]Suggestion, error) {
+	prompt, deterministic, err := r.assemblePrompt(change)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.chat(ctx, prompt)
+	if err != nil {
+		return nil, err
+	}
 
-	response, err := r.client.Chat(r.model, prompt)
+	suggestions, err := parseReviewResponse(response, change.Path)
 	if err != nil {
 		return nil, err
 	}
 
-	return parseReviewResponse(response, change.Path)
+	// If the model clearly reported findings but not in our format (prose,
+	// fenced JSON), ask it once to reformat instead of silently dropping them
+	if len(suggestions) == 0 && looksLikeUnparsedFindings(response) {
+		reformatted, err := r.chat(ctx, buildRepairPrompt(response))
+		if err == nil {
+			suggestions, _ = parseReviewResponse(reformatted, change.Path)
+		}
+	}
+
+	// Tag AI findings with their provenance before merging
+	for i := range suggestions {
+		suggestions[i].Provenance = appendProvenance(suggestions[i].Provenance, "model:"+r.modelLabel())
+	}
+
+	// Merge AI and deterministic findings; findings at the same location
+	// collapse into one suggestion with combined provenance
+	suggestions = MergeByLocation(append(suggestions, deterministic...))
+
+	// Apply profile-specific adjustments (e.g., security findings block)
+	suggestions = r.applyProfile(suggestions)
+
+	// Verify that each suggestion's original code can be located in the
+	// staged content, so applyFix doesn't fail later on mismatched snippets
+	return r.validateSuggestions(change, suggestions), nil
+}
+
+// modelLabel returns the configured model name for provenance labels
+func (r *Reviewer) modelLabel() string {
+	if r.model == "" {
+		return "default"
+	}
+	return r.model
+}
+
+// languageNames maps common ISO codes onto language names the model
+// responds to more reliably than bare codes
+var languageNames = map[string]string{
+	"ja": "Japanese",
+	"de": "German",
+	"es": "Spanish",
+	"fr": "French",
+	"it": "Italian",
+	"ko": "Korean",
+	"pt": "Portuguese",
+	"ru": "Russian",
+	"zh": "Chinese",
+}
+
+// languageContext instructs the model to write human-readable text in the
+// configured review language. Field labels and code stay untouched so the
+// parser remains locale-agnostic.
+func (r *Reviewer) languageContext() string {
+	if r.language == "" {
+		return ""
+	}
+
+	language := r.language
+	if name, ok := languageNames[strings.ToLower(language)]; ok {
+		language = name
+	}
+
+	return `
+
+OUTPUT LANGUAGE: write all TITLE and DESCRIPTION text in ` + language + `.
+Keep the field labels (LINE:, SEVERITY:, CONFIDENCE:, CATEGORY:, TITLE:, DESCRIPTION:, ORIGINAL:, FIX:), the severity/confidence/category values, and all code exactly as specified in English.
+`
+}
+
+// intentContext returns prompt context describing the developer's stated
+// intent from the prepared commit message, or an empty string when none
+// has been written yet
+func (r *Reviewer) intentContext() string {
+	if r.commitMessage == "" {
+		return ""
+	}
+	return `
+
+COMMIT INTENT (the developer's prepared commit message):
+` + r.commitMessage + `
+
+Use this as context for what the change is supposed to do:
+- Flag changes that go well beyond the stated intent (e.g., a "fix typo" commit that rewrites logic)
+- Skip suggestions that are irrelevant to this intent
+`
+}
+
+// coverageContext returns prompt context describing the file's test coverage,
+// or an empty string when no coverage report was detected
+func (r *Reviewer) coverageContext(path string) string {
+	if r.coverage == nil {
+		return ""
+	}
+
+	pct, ok := r.coverage.FileCoverage(path)
+	if !ok {
+		return ""
+	}
+
+	context := fmt.Sprintf("\n\nTEST COVERAGE: this file has %.1f%% test coverage.\n", pct)
+	if pct < 50 {
+		context += "Much of this file is NOT covered by tests - weight findings more heavily, " +
+			"since bugs here will not be caught by the test suite. Flag new branches that " +
+			"appear to lack test coverage.\n"
+	} else {
+		context += "Consider whether the changed lines fall in untested branches and flag " +
+			"new uncovered logic.\n"
+	}
+	return context
+}
+
+// buildPrompt builds the review prompt for a file change and enforces the
+// configured token budget, trimming content when necessary
+func (r *Reviewer) buildPrompt(change git.FileChange) (string, error) {
+	prompt, err := r.rebuildPrompt(change)
+	if err != nil {
+		return "", err
+	}
+	return r.enforceTokenBudget(change, prompt)
+}
+
+// rebuildPrompt renders the prompt for a file change, using the custom
+// repo template when one is configured and the built-in prompt otherwise
+func (r *Reviewer) rebuildPrompt(change git.FileChange) (string, error) {
+	if r.promptTemplate != nil {
+		return r.renderPromptTemplate(change)
+	}
+	// Test files get a prompt focused on assertion quality and flakiness
+	// instead of generic review advice
+	if isTestFile(change.Path) {
+		return buildTestReviewPrompt(change, r.standardsContext, r.projectHints), nil
+	}
+	return buildReviewPrompt(change, r.standardsContext, r.projectHints, r.tolerance, r.profile, r.coverageContext(change.Path)), nil
+}
+
+// responseFormat is the structured output block shared by all review
+// prompts; the parser depends on it exactly
+const responseFormat = `
+For each GENUINE issue found, respond in this exact format:
+---
+LINE: <line number where issue starts>
+END_LINE: <end line number if multi-line, otherwise same as LINE>
+SEVERITY: <error|warning|info|hint>
+CONFIDENCE: <high|medium|low>
+CATEGORY: <category>
+TITLE: <short title>
+DESCRIPTION: <detailed description explaining WHY this is an issue and the RISK if not fixed>
+ORIGINAL:
+<<<
+the exact original code lines copied verbatim from the file
+include multiple lines if needed, preserving all whitespace and indentation
+>>>
+FIX:
+<<<
+the exact replacement code
+include multiple lines if needed, preserving all whitespace and indentation
+>>>
+---
+
+CRITICAL RULES:
+1. ORIGINAL must be copied EXACTLY from the file content - character for character
+2. Include enough context (2-3 lines before/after) to make the match unique
+3. Preserve ALL whitespace, tabs, and indentation exactly as they appear
+4. For multi-line code, include all lines between <<< and >>>
+5. If no code fix is applicable, use: N/A (without <<< >>>)
+`
+
+// buildTestReviewPrompt creates the review prompt for a test file,
+// evaluating assertion strength, flakiness risks, and edge-case coverage
+// under the "test-quality" category
+func buildTestReviewPrompt(change git.FileChange, standardsContext string, projectHints []string) string {
+	prompt := `You are reviewing a TEST file. Do not give generic code review advice - evaluate the tests themselves:
+
+1. ASSERTION STRENGTH - Flag assertions that can't fail (assert true, comparing a value to itself),
+   tests with no assertions, and assertions so broad they would pass on wrong behavior.
+2. FLAKINESS RISKS - Flag sleeps and arbitrary timeouts, dependence on wall-clock time or dates,
+   ordering assumptions over maps/sets/goroutines, reliance on external network services,
+   and shared mutable state between tests.
+3. EDGE-CASE COVERAGE - Note important branches of the code under test that the changed tests
+   clearly do not exercise (empty input, errors, boundaries).
+4. TEST HYGIENE - Missing cleanup, leaked resources, tests that depend on execution order.
+
+Use CATEGORY: test-quality for all findings.
+` + responseFormat
+
+	if standardsContext != "" {
+		prompt += standardsContext
+	}
+
+	if len(projectHints) > 0 {
+		prompt += "\n\nPROJECT-SPECIFIC CONTEXT (trust these hints from the developer):\n"
+		for _, hint := range projectHints {
+			prompt += "- " + hint + "\n"
+		}
+	}
+
+	prompt += `
+If the tests look sound, respond with: NO_ISSUES
+
+File: ` + change.Path + `
+
+Diff:
+` + change.Diff + `
+
+Full staged content:
+` + change.Content
+
+	return prompt
 }
 
 // buildReviewPrompt creates the prompt for code review
-func buildReviewPrompt(change git.FileChange, standardsContext string, projectHints []string, tolerance string) string {
+func buildReviewPrompt(change git.FileChange, standardsContext string, projectHints []string, tolerance string, profile string, coverageContext string) string {
 	// Build tolerance-specific guidance
 	var toleranceGuidance string
 	switch tolerance {
@@ -171,6 +837,12 @@ TOLERANCE: MODERATE
 - Consider framework-specific patterns - what looks wrong might be idiomatic`
 	}
 
+	// The security profile replaces tolerance guidance with a specialized
+	// OWASP-focused brief, independent of the tolerance setting
+	if profile == "security" {
+		toleranceGuidance = securityProfilePrompt
+	}
+
 	basePrompt := `You are a pragmatic code reviewer. Your goal is to be HELPFUL, not pedantic.
 
 IMPORTANT GUIDELINES:
@@ -233,6 +905,11 @@ Focus on:
 		basePrompt += standardsContext
 	}
 
+	// Add coverage context if a report was detected
+	if coverageContext != "" {
+		basePrompt += coverageContext
+	}
+
 	// Add project-specific hints if provided
 	if len(projectHints) > 0 {
 		basePrompt += "\n\nPROJECT-SPECIFIC CONTEXT (trust these hints from the developer):\n"
@@ -255,6 +932,48 @@ Full staged content:
 	return basePrompt
 }
 
+// looksLikeUnparsedFindings reports whether a response that parsed to zero
+// suggestions actually contains review content in the wrong format
+func looksLikeUnparsedFindings(response string) bool {
+	trimmed := strings.TrimSpace(response)
+	if trimmed == "" || strings.Contains(trimmed, "NO_ISSUES") {
+		return false
+	}
+	// Prose answers, fenced JSON, or markdown-formatted reviews are all
+	// substantially longer than an empty verdict
+	return len(trimmed) > 80
+}
+
+// buildRepairPrompt asks the model to reformat a malformed review response
+// into the structured format the parser expects
+func buildRepairPrompt(response string) string {
+	return `Your previous code review answer was not in the required format, so it could not be parsed.
+Reformat EVERY issue from your previous answer into this exact format, one block per issue:
+---
+LINE: <line number where issue starts>
+END_LINE: <end line number if multi-line, otherwise same as LINE>
+SEVERITY: <error|warning|info|hint>
+CONFIDENCE: <high|medium|low>
+CATEGORY: <security|performance|style|bug|best-practice>
+TITLE: <short title>
+DESCRIPTION: <detailed description>
+ORIGINAL:
+<<<
+the exact original code lines
+>>>
+FIX:
+<<<
+the exact replacement code
+>>>
+---
+
+Do not add new issues, drop issues, or include any prose outside the blocks.
+If your previous answer reported no issues, respond with: NO_ISSUES
+
+Your previous answer:
+` + response
+}
+
 // parseReviewResponse parses the AI response into suggestions
 func parseReviewResponse(response string, file string) ([]Suggestion, error) {
 	var suggestions []Suggestion
@@ -334,7 +1053,7 @@ func parseStructuredResponse(response string, file string) []Suggestion {
 		} else if hasPrefix(line, "CONFIDENCE:") {
 			current.Confidence = Confidence(parseStringValue(line, "CONFIDENCE:"))
 		} else if hasPrefix(line, "CATEGORY:") {
-			current.Category = parseStringValue(line, "CATEGORY:")
+			current.Category = NormalizeCategory(parseStringValue(line, "CATEGORY:"))
 		} else if hasPrefix(line, "TITLE:") {
 			current.Title = parseStringValue(line, "TITLE:")
 		} else if hasPrefix(line, "DESCRIPTION:") {
@@ -0,0 +1,121 @@
+package review
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// shellcheckFinding mirrors one entry of shellcheck's JSON output
+type shellcheckFinding struct {
+	Line    int    `json:"line"`
+	EndLine int    `json:"endLine"`
+	Level   string `json:"level"` // error, warning, info, style
+	Code    int    `json:"code"`  // e.g., 2086 for SC2086
+	Message string `json:"message"`
+}
+
+// isShellScript reports whether a changed file is a shell script
+func isShellScript(change git.FileChange) bool {
+	switch strings.ToLower(filepath.Ext(change.Path)) {
+	case ".sh", ".bash":
+		return true
+	}
+	// Also detect extensionless scripts by shebang
+	return strings.HasPrefix(change.Content, "#!") &&
+		(strings.Contains(firstLine(change.Content), "sh") ||
+			strings.Contains(firstLine(change.Content), "bash"))
+}
+
+func firstLine(s string) string {
+	if idx := strings.IndexByte(s, '\n'); idx >= 0 {
+		return s[:idx]
+	}
+	return s
+}
+
+// runShellcheck runs shellcheck on the staged content if the tool is
+// installed, returning nil when it is unavailable or fails
+func runShellcheck(content string) []shellcheckFinding {
+	if _, err := exec.LookPath("shellcheck"); err != nil {
+		return nil
+	}
+
+	cmd := exec.Command("shellcheck", "--format=json", "-")
+	cmd.Stdin = strings.NewReader(content)
+	// shellcheck exits non-zero when findings exist, so ignore the error
+	// as long as we got parseable output
+	output, _ := cmd.Output()
+	if len(output) == 0 {
+		return nil
+	}
+
+	var findings []shellcheckFinding
+	if err := json.Unmarshal(output, &findings); err != nil {
+		return nil
+	}
+	return findings
+}
+
+// shellcheckContext formats shellcheck findings as prompt context so the
+// model can explain them and propose fixes instead of re-deriving them
+func shellcheckContext(findings []shellcheckFinding) string {
+	if len(findings) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n\nSHELLCHECK FINDINGS (from running shellcheck on this script):\n")
+	for _, f := range findings {
+		sb.WriteString(fmt.Sprintf("- line %d [SC%d, %s]: %s\n", f.Line, f.Code, f.Level, f.Message))
+	}
+	sb.WriteString(`
+These findings come from a deterministic linter and are reliable. Where relevant,
+incorporate them into your review with concrete fixes, and focus your own analysis
+on issues shellcheck cannot detect (logic errors, quoting intent, portability).
+`)
+	return sb.String()
+}
+
+// shellcheckSuggestions converts shellcheck findings into suggestions so
+// they appear in the review even if the model ignores them
+func shellcheckSuggestions(findings []shellcheckFinding, file string) []Suggestion {
+	suggestions := make([]Suggestion, 0, len(findings))
+	for _, f := range findings {
+		endLine := f.EndLine
+		if endLine == 0 {
+			endLine = f.Line
+		}
+		suggestions = append(suggestions, Suggestion{
+			File:        file,
+			Line:        f.Line,
+			EndLine:     endLine,
+			Severity:    shellcheckSeverity(f.Level),
+			Confidence:  ConfidenceHigh, // Deterministic tool output
+			Category:    "shell",
+			Title:       fmt.Sprintf("SC%d: %s", f.Code, f.Message),
+			Description: fmt.Sprintf("Reported by shellcheck (https://www.shellcheck.net/wiki/SC%d).", f.Code),
+			Source:      "shellcheck",
+			Provenance:  []string{fmt.Sprintf("shellcheck:SC%d", f.Code)},
+		})
+	}
+	return suggestions
+}
+
+// shellcheckSeverity maps shellcheck levels onto review severities
+func shellcheckSeverity(level string) Severity {
+	switch level {
+	case "error":
+		return SeverityError
+	case "warning":
+		return SeverityWarning
+	case "info":
+		return SeverityInfo
+	default: // style
+		return SeverityHint
+	}
+}
@@ -0,0 +1,124 @@
+package review
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ResultStats is an aggregate breakdown of a review result, used by the
+// session summary and report footers
+type ResultStats struct {
+	BySeverity map[Severity]int
+	ByCategory map[string]int
+	Models     []string // Models that contributed findings, from provenance
+}
+
+// Stats computes severity/category counts and the set of contributing
+// models for a review result
+func (r *ReviewResult) Stats() ResultStats {
+	stats := ResultStats{
+		BySeverity: make(map[Severity]int),
+		ByCategory: make(map[string]int),
+	}
+
+	models := make(map[string]bool)
+	for _, s := range r.Suggestions {
+		stats.BySeverity[s.Severity]++
+		if s.Category != "" {
+			stats.ByCategory[s.Category]++
+		}
+		for _, prov := range s.Provenance {
+			if model, ok := strings.CutPrefix(prov, "model:"); ok {
+				models[model] = true
+			}
+		}
+	}
+
+	for model := range models {
+		stats.Models = append(stats.Models, model)
+	}
+	sort.Strings(stats.Models)
+
+	return stats
+}
+
+// SeverityLine renders the severity breakdown as a single line, ordered
+// from most to least severe (e.g. "1 error, 4 warnings")
+func (s ResultStats) SeverityLine() string {
+	var parts []string
+	for _, sev := range []Severity{SeverityError, SeverityWarning, SeverityInfo, SeverityHint} {
+		if count := s.BySeverity[sev]; count > 0 {
+			label := string(sev)
+			if count != 1 {
+				label += "s"
+			}
+			parts = append(parts, formatCount(count, label))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// CategoryLine renders the category breakdown as a single line, largest
+// categories first (e.g. "2 security, 3 style")
+func (s ResultStats) CategoryLine() string {
+	categories := make([]string, 0, len(s.ByCategory))
+	for category := range s.ByCategory {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if s.ByCategory[categories[i]] != s.ByCategory[categories[j]] {
+			return s.ByCategory[categories[i]] > s.ByCategory[categories[j]]
+		}
+		return categories[i] < categories[j]
+	})
+
+	var parts []string
+	for _, category := range categories {
+		parts = append(parts, formatCount(s.ByCategory[category], category))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatCount(count int, label string) string {
+	return strconv.Itoa(count) + " " + label
+}
+
+// StatsBlock renders the compact post-review statistics table: file and
+// finding totals, severity and category breakdowns, and per-file cost,
+// one line per entry for the caller to print
+func (r *ReviewResult) StatsBlock() []string {
+	stats := r.Stats()
+
+	withFindings := make(map[string]bool)
+	for _, s := range r.Suggestions {
+		withFindings[s.File] = true
+	}
+
+	lines := []string{
+		fmt.Sprintf("Files reviewed:  %d (%d with findings)", len(r.Files), len(withFindings)),
+	}
+	if line := stats.SeverityLine(); line != "" {
+		lines = append(lines, "Findings:        "+line)
+	}
+	if line := stats.CategoryLine(); line != "" {
+		lines = append(lines, "Categories:      "+line)
+	}
+
+	if len(r.FileStats) > 0 {
+		width := 0
+		for _, fs := range r.FileStats {
+			if len(fs.File) > width {
+				width = len(fs.File)
+			}
+		}
+		lines = append(lines, "Per file:")
+		for _, fs := range r.FileStats {
+			lines = append(lines, fmt.Sprintf("  %-*s  %d finding(s)  %4.1fs  %s tokens",
+				width, fs.File, fs.Findings, fs.Seconds, formatTokens(fs.Tokens)))
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,52 @@
+package review
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// lastReviewFile is where the most recent review result is persisted,
+// under the prereview data directory
+const lastReviewFile = "last-review.json"
+
+// SuggestionID returns a short, stable identifier for a suggestion,
+// derived from its location and title. IDs let CI bots and editor plugins
+// refer to a specific finding (e.g., `prereview apply --suggestion <id>`).
+func SuggestionID(s Suggestion) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("%s:%d:%s", s.File, s.Line, s.Title)))
+	return hex.EncodeToString(sum[:])[:8]
+}
+
+// SaveResult persists a review result to the prereview data directory so
+// later commands (apply, summarize) can operate on it without re-reviewing
+func SaveResult(dir string, result *ReviewResult) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode review result: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, lastReviewFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to save review result: %w", err)
+	}
+	return nil
+}
+
+// LoadResult loads the most recently saved review result
+func LoadResult(dir string) (*ReviewResult, error) {
+	data, err := os.ReadFile(filepath.Join(dir, lastReviewFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no saved review found - run 'prereview' first")
+		}
+		return nil, fmt.Errorf("failed to read saved review: %w", err)
+	}
+
+	var result ReviewResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse saved review: %w", err)
+	}
+	return &result, nil
+}
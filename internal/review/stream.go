@@ -0,0 +1,84 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/emilushi/prereview/internal/llm"
+)
+
+// chat sends a prompt to the provider, streaming the response when the
+// provider supports it so long generations show a live receiving indicator
+// instead of sitting silent until the response is complete. A configured
+// request_timeout bounds each request; cancelling ctx aborts it.
+func (r *Reviewer) chat(ctx context.Context, prompt string) (string, error) {
+	if r.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.requestTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+
+	streamer, ok := r.client.(llm.Streamer)
+	if !ok || !stdoutIsTerminal() {
+		response, err := r.client.Chat(ctx, r.model, prompt)
+		if err == nil {
+			r.recordUsage(prompt, response)
+			r.logTiming(prompt, response, start)
+		}
+		return response, err
+	}
+
+	// Report a live receiving status: received size plus a running count
+	// of findings parsed so far (each structured block starts LINE:). The
+	// status goes through the display so it shares the terminal line with
+	// whatever progress rendering is installed instead of fighting it.
+	var received int
+	var partial strings.Builder
+	response, err := streamer.ChatStream(ctx, r.model, prompt, func(delta string) {
+		received += len(delta)
+		partial.WriteString(delta)
+		status := fmt.Sprintf("… receiving (%d chars", received)
+		if findings := strings.Count(partial.String(), "LINE:"); findings > 0 {
+			status += fmt.Sprintf(", ~%d finding(s)", findings)
+		}
+		status += ")"
+		r.display.Streaming(status)
+	})
+
+	// Clear the status before normal output resumes
+	if received > 0 {
+		r.display.Streaming("")
+	}
+
+	if err == nil {
+		r.recordUsage(prompt, response)
+		r.logTiming(prompt, response, start)
+	}
+	return response, err
+}
+
+// logTiming reports a request's prompt size and duration to stderr in
+// verbose mode, so slow reviews can be attributed to prompt size or the
+// provider
+func (r *Reviewer) logTiming(prompt, response string, start time.Time) {
+	if !r.verbose {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "    request: %d prompt chars, %d response chars, %.1fs\n",
+		len(prompt), len(response), time.Since(start).Seconds())
+}
+
+// stdoutIsTerminal reports whether stdout is attached to a terminal, so
+// in-place status rendering doesn't corrupt piped or CI output
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,132 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// perGroupDiffTokens caps how much diff each file contributes to a group
+// prompt in summarized mode
+const perGroupDiffTokens = 1500
+
+// totalEstimatedTokens approximates the token cost of reviewing a changeset
+// file by file, using staged sizes so nothing has to be loaded up front
+func totalEstimatedTokens(changes []git.FileChange) int {
+	total := 0
+	for _, change := range changes {
+		size := change.Size
+		if size == 0 {
+			size = int64(len(change.Content))
+		}
+		total += int(size+3) / 4
+	}
+	return total
+}
+
+// reviewSummarized performs a shallow, budget-aware pass over a huge
+// changeset: instead of line-level findings, it asks the model for a
+// high-level risk assessment per file group and flags which files deserve
+// a follow-up deep review. The output is clearly labeled as shallow.
+func (r *Reviewer) reviewSummarized(ctx context.Context, changes []git.FileChange) (*ReviewResult, error) {
+	r.usage = Usage{}
+	result := &ReviewResult{
+		Files: make([]string, 0, len(changes)),
+	}
+	for _, change := range changes {
+		result.Files = append(result.Files, change.Path)
+	}
+
+	groups := groupByDirectory(changes)
+	groupNames := make([]string, 0, len(groups))
+	for name := range groups {
+		groupNames = append(groupNames, name)
+	}
+	sort.Strings(groupNames)
+
+	fmt.Printf("  ⚠ Changeset exceeds the review budget - running a SHALLOW summarized pass (%d file group(s))\n", len(groups))
+
+	for i, name := range groupNames {
+		if err := ctx.Err(); err != nil {
+			result.Usage = r.usage
+			return result, err
+		}
+
+		group := groups[name]
+		fmt.Printf("  [%d/%d] Assessing %s (%d file(s))...\n", i+1, len(groupNames), name, len(group))
+
+		assessment, err := r.assessGroup(ctx, name, group)
+		if err != nil {
+			fmt.Printf("    ✗ Error: %v\n", err)
+			continue
+		}
+
+		result.Suggestions = append(result.Suggestions, Suggestion{
+			File:        group[0].Path,
+			Line:        1,
+			Severity:    SeverityInfo,
+			Confidence:  ConfidenceLow,
+			Category:    "risk-summary",
+			Title:       fmt.Sprintf("Shallow pass: %s", name),
+			Description: assessment,
+			Source:      "summary",
+			Provenance:  []string{"pass:summarized"},
+		})
+	}
+
+	result.Summary = "SHALLOW PASS: this changeset exceeded the review budget, so files were assessed " +
+		"at group level instead of line by line. Findings above indicate risk areas only - run " +
+		"'prereview deep <file>' on the files they single out for a thorough review."
+
+	result.Usage = r.usage
+	return result, nil
+}
+
+// assessGroup asks the model for a high-level risk assessment of one file
+// group, feeding it truncated diffs rather than full contents
+func (r *Reviewer) assessGroup(ctx context.Context, name string, group []git.FileChange) (string, error) {
+	var sb strings.Builder
+	sb.WriteString(`You are reviewing a very large changeset that exceeds the line-level review budget.
+Give a HIGH-LEVEL risk assessment of the following file group (plain text, no markdown, max 6 sentences):
+- Overall risk level (low/medium/high) and the main concerns
+- Which specific files deserve a follow-up deep review, and why
+
+Do not report line-level findings.
+
+File group: ` + name + "\n")
+
+	for _, change := range group {
+		if err := change.EnsureLoaded(); err != nil {
+			continue
+		}
+		sb.WriteString("\nFile: " + change.Path + " (" + change.Status + ")\n")
+		sb.WriteString("Diff:\n" + truncateToTokens(change.Diff, perGroupDiffTokens) + "\n")
+		change.Release()
+	}
+
+	assessment, err := r.chat(ctx, sb.String())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(assessment), nil
+}
+
+// groupByDirectory groups changes by their top-level directory, the
+// granularity used for shallow assessments
+func groupByDirectory(changes []git.FileChange) map[string][]git.FileChange {
+	groups := make(map[string][]git.FileChange)
+	for _, change := range changes {
+		name := filepath.ToSlash(change.Path)
+		if idx := strings.Index(name, "/"); idx >= 0 {
+			name = name[:idx] + "/"
+		} else {
+			name = "(root)"
+		}
+		groups[name] = append(groups[name], change)
+	}
+	return groups
+}
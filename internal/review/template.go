@@ -0,0 +1,65 @@
+package review
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// promptTemplatePath is the repo-relative location of a custom prompt template
+const promptTemplatePath = ".prereview/prompt.tmpl"
+
+// PromptData is the data available to a custom prompt template
+type PromptData struct {
+	Change    git.FileChange // The file change being reviewed
+	Standards string         // Detected coding standards context
+	Hints     []string       // Project-specific hints from config
+	Tolerance string         // strict, moderate, relaxed
+	Coverage  string         // Coverage context for the file, if available
+}
+
+// loadPromptTemplate loads a custom prompt template from the repo if one
+// exists. Returns nil when no template is configured; parse errors are
+// returned so the user learns their template is broken instead of silently
+// getting the default prompt.
+func loadPromptTemplate(repoRoot string) (*template.Template, error) {
+	if repoRoot == "" {
+		return nil, nil
+	}
+
+	path := filepath.Join(repoRoot, promptTemplatePath)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read prompt template %s: %w", promptTemplatePath, err)
+	}
+
+	tmpl, err := template.New("prompt").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse prompt template %s: %w", promptTemplatePath, err)
+	}
+
+	return tmpl, nil
+}
+
+// renderPromptTemplate executes the custom template for a file change
+func (r *Reviewer) renderPromptTemplate(change git.FileChange) (string, error) {
+	var sb strings.Builder
+	err := r.promptTemplate.Execute(&sb, PromptData{
+		Change:    change,
+		Standards: r.standardsContext,
+		Hints:     r.projectHints,
+		Tolerance: r.tolerance,
+		Coverage:  r.coverageContext(change.Path),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to execute prompt template: %w", err)
+	}
+	return sb.String(), nil
+}
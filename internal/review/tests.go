@@ -0,0 +1,176 @@
+package review
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// Patterns matching function definitions on added diff lines, per language
+var (
+	goFuncPattern     = regexp.MustCompile(`^\+func (?:\([^)]+\) )?([A-Za-z_]\w*)\(`)
+	pythonFuncPattern = regexp.MustCompile(`^\+(?:async )?def ([A-Za-z_]\w*)\(`)
+	jsFuncPattern     = regexp.MustCompile(`^\+(?:export )?(?:async )?function ([A-Za-z_$]\w*)\(`)
+)
+
+// isTestFile reports whether a path is a test file by common naming
+// conventions across languages
+func isTestFile(path string) bool {
+	base := strings.ToLower(filepath.Base(path))
+	return strings.HasSuffix(base, "_test.go") ||
+		strings.HasPrefix(base, "test_") ||
+		strings.HasSuffix(base, "_test.py") ||
+		strings.Contains(base, ".test.") ||
+		strings.Contains(base, ".spec.")
+}
+
+// testNudge notices when a change adds functions without touching any
+// corresponding test file and returns a low-confidence "missing tests"
+// suggestion with a generated test skeleton. Returns nil when there is
+// nothing to nudge about.
+func testNudge(change git.FileChange, changedPaths map[string]bool) *Suggestion {
+	if isTestFile(change.Path) {
+		return nil
+	}
+
+	pattern := funcPatternFor(change.Path)
+	if pattern == nil {
+		return nil
+	}
+
+	var added []string
+	for _, line := range strings.Split(change.Diff, "\n") {
+		if strings.HasPrefix(line, "+++") {
+			continue
+		}
+		if m := pattern.FindStringSubmatch(line); m != nil {
+			added = append(added, m[1])
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	// A matching test file in the same changeset means tests came along
+	for path := range changedPaths {
+		if isTestFile(path) && testFileMatches(path, change.Path) {
+			return nil
+		}
+	}
+
+	return &Suggestion{
+		File:       change.Path,
+		Line:       1,
+		Severity:   SeverityHint,
+		Confidence: ConfidenceLow,
+		Category:   "testing",
+		Title:      "New functions without test changes",
+		Description: fmt.Sprintf("This change adds %s but no corresponding test file is part of the changeset. "+
+			"Consider adding tests alongside the new code.", formatFunctionList(added)),
+		SuggestFix: testSkeleton(change.Path, added),
+		Source:     "tests",
+		Provenance: []string{"rule:suggest-tests"},
+	}
+}
+
+// funcPatternFor returns the added-function pattern for a file's language,
+// or nil for languages without one
+func funcPatternFor(path string) *regexp.Regexp {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".go":
+		return goFuncPattern
+	case ".py":
+		return pythonFuncPattern
+	case ".js", ".jsx", ".ts", ".tsx", ".mjs":
+		return jsFuncPattern
+	}
+	return nil
+}
+
+// testFileMatches reports whether a test file plausibly covers a source
+// file, by shared base name or shared directory
+func testFileMatches(testPath, sourcePath string) bool {
+	sourceBase := strings.TrimSuffix(filepath.Base(sourcePath), filepath.Ext(sourcePath))
+	testBase := strings.ToLower(filepath.Base(testPath))
+	if strings.Contains(testBase, strings.ToLower(sourceBase)) {
+		return true
+	}
+	return filepath.Dir(testPath) == filepath.Dir(sourcePath)
+}
+
+// formatFunctionList renders a short human-readable list of function names
+func formatFunctionList(names []string) string {
+	const maxShown = 4
+	shown := names
+	if len(shown) > maxShown {
+		shown = shown[:maxShown]
+	}
+	list := "function(s) " + strings.Join(shown, ", ")
+	if len(names) > maxShown {
+		list += fmt.Sprintf(" (and %d more)", len(names)-maxShown)
+	}
+	return list
+}
+
+// testSkeleton generates a minimal test file skeleton for the added
+// functions, in the file's language
+func testSkeleton(path string, funcs []string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".go":
+		var sb strings.Builder
+		sb.WriteString("// " + testFileName(path) + "\npackage " + guessGoPackage(path) + "\n\nimport \"testing\"\n")
+		for _, name := range funcs {
+			sb.WriteString(fmt.Sprintf("\nfunc Test%s(t *testing.T) {\n\tt.Skip(\"TODO: test %s\")\n}\n", exportedName(name), name))
+		}
+		return sb.String()
+	case ".py":
+		var sb strings.Builder
+		sb.WriteString("# " + testFileName(path) + "\n")
+		for _, name := range funcs {
+			sb.WriteString(fmt.Sprintf("\ndef test_%s():\n    assert False, \"TODO: test %s\"\n", name, name))
+		}
+		return sb.String()
+	default: // JavaScript/TypeScript
+		var sb strings.Builder
+		sb.WriteString("// " + testFileName(path) + "\n")
+		for _, name := range funcs {
+			sb.WriteString(fmt.Sprintf("\ntest('%s', () => {\n  // TODO: test %s\n});\n", name, name))
+		}
+		return sb.String()
+	}
+}
+
+// testFileName suggests the conventional test file name for a source file
+func testFileName(path string) string {
+	ext := filepath.Ext(path)
+	base := strings.TrimSuffix(filepath.Base(path), ext)
+	switch strings.ToLower(ext) {
+	case ".go":
+		return base + "_test.go"
+	case ".py":
+		return "test_" + base + ".py"
+	default:
+		return base + ".test" + ext
+	}
+}
+
+// guessGoPackage derives a plausible package name from the file's directory
+func guessGoPackage(path string) string {
+	dir := filepath.Base(filepath.Dir(path))
+	if dir == "." || dir == "/" || dir == "" {
+		return "main"
+	}
+	return strings.ReplaceAll(dir, "-", "")
+}
+
+// exportedName upper-cases the first letter so the generated Go test name
+// is valid for both exported and unexported functions
+func exportedName(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
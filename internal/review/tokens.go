@@ -0,0 +1,99 @@
+package review
+
+import (
+	"fmt"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// EstimateTokens approximates the token count of a prompt. Modern tokenizers
+// average close to four characters per token for mixed code and prose, which
+// is accurate enough for budgeting without shipping a tokenizer per model.
+func EstimateTokens(s string) int {
+	return (len(s) + 3) / 4
+}
+
+// truncationMarker is appended wherever content was cut to fit the budget
+const truncationMarker = "\n... (truncated to fit token budget)"
+
+// fitTokenBudget trims a change's content and diff so the resulting prompt
+// stays within maxTokens. overheadTokens is the prompt size excluding the
+// content and diff. Returns the adjusted change and whether trimming occurred.
+func fitTokenBudget(change git.FileChange, maxTokens int, overheadTokens int) (git.FileChange, bool) {
+	available := maxTokens - overheadTokens
+	if available <= 0 {
+		// The fixed prompt alone exceeds the budget; keep a minimal slice of
+		// content so the request still carries some signal
+		available = maxTokens / 4
+	}
+
+	used := EstimateTokens(change.Content) + EstimateTokens(change.Diff)
+	if used <= available {
+		return change, false
+	}
+
+	// The full content matters more than the diff for accurate snippets,
+	// so give it the larger share of the remaining budget
+	contentBudget := available * 7 / 10
+	diffBudget := available - contentBudget
+
+	change.Content = truncateToTokens(change.Content, contentBudget)
+	change.Diff = truncateToTokens(change.Diff, diffBudget)
+	return change, true
+}
+
+// truncateToTokens cuts s to approximately maxTokens, at a line boundary,
+// and appends a marker so the model knows content is missing
+func truncateToTokens(s string, maxTokens int) string {
+	if EstimateTokens(s) <= maxTokens {
+		return s
+	}
+
+	maxChars := maxTokens * 4
+	if maxChars <= 0 {
+		return truncationMarker
+	}
+	if maxChars > len(s) {
+		maxChars = len(s)
+	}
+
+	cut := s[:maxChars]
+	// Prefer ending at a line boundary so we don't feed half a line
+	if idx := lastNewline(cut); idx > 0 {
+		cut = cut[:idx]
+	}
+	return cut + truncationMarker
+}
+
+func lastNewline(s string) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == '\n' {
+			return i
+		}
+	}
+	return -1
+}
+
+// enforceTokenBudget rebuilds the prompt with trimmed content when it
+// exceeds the configured budget, and reports the truncation to the user
+func (r *Reviewer) enforceTokenBudget(change git.FileChange, prompt string) (string, error) {
+	if r.maxPromptTokens <= 0 {
+		return prompt, nil
+	}
+
+	total := EstimateTokens(prompt)
+	if total <= r.maxPromptTokens {
+		return prompt, nil
+	}
+
+	overhead := total - EstimateTokens(change.Content) - EstimateTokens(change.Diff)
+	trimmed, didTrim := fitTokenBudget(change, r.maxPromptTokens, overhead)
+	if !didTrim {
+		return prompt, nil
+	}
+
+	fmt.Printf("    ⚠ Prompt for %s (~%d tokens) exceeds max_prompt_tokens=%d, content truncated\n",
+		change.Path, total, r.maxPromptTokens)
+
+	return r.rebuildPrompt(trimmed)
+}
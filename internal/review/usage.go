@@ -0,0 +1,167 @@
+package review
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/emilushi/prereview/internal/llm"
+)
+
+// usageLedgerFile is the file in the prereview data directory where token
+// totals are accumulated across runs, queryable via `prereview stats`
+const usageLedgerFile = "usage.json"
+
+// Usage accumulates token counts across the requests of one review run
+type Usage struct {
+	Requests         int  `json:"requests"`
+	PromptTokens     int  `json:"prompt_tokens"`
+	CompletionTokens int  `json:"completion_tokens"`
+	Estimated        bool `json:"estimated"` // True when any count was estimated rather than provider-reported
+}
+
+// modelPricing maps model names (and their common aliases) to [input, output]
+// prices in USD per million tokens. Models not listed here get token counts
+// but no cost estimate.
+var modelPricing = map[string][2]float64{
+	"gpt-4o-mini":       {0.15, 0.60},
+	"gpt-4o":            {2.50, 10.00},
+	"gpt-4":             {2.50, 10.00},
+	"gpt-4.1":           {2.00, 8.00},
+	"o1-preview":        {15.00, 60.00},
+	"o1-mini":           {1.10, 4.40},
+	"claude":            {3.00, 15.00},
+	"claude-sonnet":     {3.00, 15.00},
+	"claude-sonnet-4":   {3.00, 15.00},
+	"claude-sonnet-4.5": {3.00, 15.00},
+	"claude-opus-4.5":   {5.00, 25.00},
+	"claude-haiku-4.5":  {1.00, 5.00},
+	"gemini":            {1.25, 10.00},
+	"gemini-2.5-pro":    {1.25, 10.00},
+}
+
+// TotalTokens returns the combined prompt and completion token count
+func (u Usage) TotalTokens() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// Cost estimates the run's cost in USD for the given model; ok is false
+// when the model has no known pricing
+func (u Usage) Cost(model string) (cost float64, ok bool) {
+	prices, ok := modelPricing[strings.ToLower(model)]
+	if !ok {
+		return 0, false
+	}
+	cost = float64(u.PromptTokens)/1e6*prices[0] + float64(u.CompletionTokens)/1e6*prices[1]
+	return cost, true
+}
+
+// Summary renders a compact one-line account of the run, e.g.
+// "12 request(s), 48.2k tokens, ~$0.09". Empty when no requests were made.
+func (u Usage) Summary(model string) string {
+	if u.Requests == 0 {
+		return ""
+	}
+	line := fmt.Sprintf("%d request(s), %s tokens", u.Requests, formatTokens(u.TotalTokens()))
+	if cost, ok := u.Cost(model); ok {
+		line += fmt.Sprintf(", ~$%.2f", cost)
+	}
+	if u.Estimated {
+		line += " (token counts estimated)"
+	}
+	return line
+}
+
+// formatTokens renders a token count compactly (850, 48.2k, 1.3M)
+func formatTokens(tokens int) string {
+	switch {
+	case tokens >= 1_000_000:
+		return fmt.Sprintf("%.1fM", float64(tokens)/1e6)
+	case tokens >= 1_000:
+		return fmt.Sprintf("%.1fk", float64(tokens)/1e3)
+	default:
+		return fmt.Sprintf("%d", tokens)
+	}
+}
+
+// recordUsage attributes one completed request to the run's usage, preferring
+// provider-reported token counts and falling back to the ~4 chars per token
+// estimate the prompt budget uses
+func (r *Reviewer) recordUsage(prompt, response string) {
+	r.usage.Requests++
+	if reporter, ok := r.client.(llm.UsageReporter); ok {
+		if promptTokens, completionTokens, ok := reporter.LastUsage(); ok {
+			r.usage.PromptTokens += promptTokens
+			r.usage.CompletionTokens += completionTokens
+			return
+		}
+	}
+	r.usage.PromptTokens += (len(prompt) + 3) / 4
+	r.usage.CompletionTokens += (len(response) + 3) / 4
+	r.usage.Estimated = true
+}
+
+// UsageLedger accumulates token totals across runs in one repository
+type UsageLedger struct {
+	Since            time.Time `json:"since"`
+	Runs             int       `json:"runs"`
+	Requests         int       `json:"requests"`
+	PromptTokens     int       `json:"prompt_tokens"`
+	CompletionTokens int       `json:"completion_tokens"`
+	CostUSD          float64   `json:"cost_usd"`
+	Estimated        bool      `json:"estimated"` // True when any run's counts were estimated
+}
+
+// AccumulateUsage folds one run's usage into the ledger under dir
+func AccumulateUsage(dir string, usage Usage, model string) error {
+	if usage.Requests == 0 {
+		return nil
+	}
+
+	ledger, err := LoadUsageLedger(dir)
+	if err != nil {
+		return err
+	}
+	if ledger == nil {
+		ledger = &UsageLedger{Since: time.Now()}
+	}
+
+	ledger.Runs++
+	ledger.Requests += usage.Requests
+	ledger.PromptTokens += usage.PromptTokens
+	ledger.CompletionTokens += usage.CompletionTokens
+	ledger.Estimated = ledger.Estimated || usage.Estimated
+	if cost, ok := usage.Cost(model); ok {
+		ledger.CostUSD += cost
+	}
+
+	data, err := json.MarshalIndent(ledger, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode usage ledger: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, usageLedgerFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to save usage ledger: %w", err)
+	}
+	return nil
+}
+
+// LoadUsageLedger reads the ledger under dir. A missing ledger yields nil,
+// not an error.
+func LoadUsageLedger(dir string) (*UsageLedger, error) {
+	data, err := os.ReadFile(filepath.Join(dir, usageLedgerFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read usage ledger: %w", err)
+	}
+
+	var ledger UsageLedger
+	if err := json.Unmarshal(data, &ledger); err != nil {
+		return nil, fmt.Errorf("failed to parse usage ledger: %w", err)
+	}
+	return &ledger, nil
+}
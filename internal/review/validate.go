@@ -0,0 +1,118 @@
+package review
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// validateSuggestions checks every suggestion's OriginalCode against the
+// staged content. Snippets that only differ in whitespace are rewritten to
+// the exact text from the file so applyFix can find them; snippets that
+// cannot be located at all get one regeneration attempt before being
+// marked unfixable.
+func (r *Reviewer) validateSuggestions(change git.FileChange, suggestions []Suggestion) []Suggestion {
+	for i := range suggestions {
+		s := &suggestions[i]
+
+		if s.OriginalCode == "" {
+			continue
+		}
+
+		// Exact match - nothing to do
+		if strings.Contains(change.Content, s.OriginalCode) {
+			continue
+		}
+
+		// Whitespace-normalized match: rewrite OriginalCode to the exact
+		// snippet from the file so later replacement succeeds
+		if exact, ok := FindNormalizedMatch(change.Content, s.OriginalCode); ok {
+			s.OriginalCode = exact
+			continue
+		}
+
+		// Last resort: ask the model once for an exact snippet
+		if regenerated, ok := r.regenerateSnippet(change, *s); ok {
+			s.OriginalCode = regenerated
+			continue
+		}
+
+		s.Unfixable = true
+	}
+
+	return suggestions
+}
+
+// FindNormalizedMatch searches content for a run of lines whose
+// whitespace-trimmed form matches the trimmed lines of snippet. On success
+// it returns the exact text from content so string replacement works.
+func FindNormalizedMatch(content string, snippet string) (string, bool) {
+	snippetLines := splitLines(strings.Trim(snippet, "\n"))
+	if len(snippetLines) == 0 {
+		return "", false
+	}
+
+	normalized := make([]string, len(snippetLines))
+	for i, line := range snippetLines {
+		normalized[i] = strings.TrimSpace(line)
+	}
+
+	contentLines := splitLines(content)
+	for start := 0; start+len(normalized) <= len(contentLines); start++ {
+		match := true
+		for j, want := range normalized {
+			if strings.TrimSpace(contentLines[start+j]) != want {
+				match = false
+				break
+			}
+		}
+		if match {
+			return joinLines(contentLines[start : start+len(normalized)]), true
+		}
+	}
+
+	return "", false
+}
+
+// regenerateSnippet asks the model once to re-copy the exact original code
+// for a suggestion whose snippet could not be located in the file.
+func (r *Reviewer) regenerateSnippet(change git.FileChange, s Suggestion) (string, bool) {
+	prompt := `A code review suggestion referenced original code that does not appear verbatim in the file.
+Copy the EXACT lines from the file content below that the suggestion refers to - character for character,
+preserving all whitespace, tabs, and indentation. Respond with ONLY the code lines, no explanation,
+no markdown fences. If you cannot identify the lines, respond with: NOT_FOUND
+
+Suggestion title: ` + s.Title + `
+Suggestion description: ` + s.Description + `
+Approximate location: line ` + strconv.Itoa(s.Line) + `
+
+Snippet that failed to match:
+` + s.OriginalCode + `
+
+File: ` + change.Path + `
+
+Full staged content:
+` + change.Content
+
+	response, err := r.chat(context.Background(), prompt)
+	if err != nil {
+		return "", false
+	}
+
+	response = strings.Trim(response, "\n")
+	if response == "" || response == "NOT_FOUND" {
+		return "", false
+	}
+
+	// Only accept the regenerated snippet if it actually matches
+	if strings.Contains(change.Content, response) {
+		return response, true
+	}
+	if exact, ok := FindNormalizedMatch(change.Content, response); ok {
+		return exact, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,36 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// VerifyFix asks the model whether a just-applied fix introduces any new
+// issue, closing the loop on the "fixes must not introduce new issues" rule
+// that the review prompt alone can't enforce. The patched argument is the
+// modified region with surrounding context. An empty note means the fix
+// looks clean.
+func (r *Reviewer) VerifyFix(sug Suggestion, patched string) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("A code review fix was just applied. Sanity-check ONLY whether the fix introduces a NEW problem ")
+	sb.WriteString("(syntax error, changed behavior, broken reference, new bug). Do not re-review the surrounding code.\n\n")
+	sb.WriteString(fmt.Sprintf("Original finding: %s\n", sug.Title))
+	if sug.Description != "" {
+		sb.WriteString(sug.Description + "\n")
+	}
+	sb.WriteString(fmt.Sprintf("\nPatched region of %s (fix already applied):\n```\n%s\n```\n\n", sug.File, patched))
+	sb.WriteString("If the fix is fine, reply with exactly: OK\n")
+	sb.WriteString("Otherwise reply with ONE short sentence describing the new problem.")
+
+	response, err := r.chat(context.Background(), sb.String())
+	if err != nil {
+		return "", err
+	}
+
+	note := strings.TrimSpace(response)
+	if note == "" || strings.EqualFold(strings.TrimRight(note, "."), "ok") {
+		return "", nil
+	}
+	return note, nil
+}
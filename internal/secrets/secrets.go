@@ -0,0 +1,156 @@
+package secrets
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// Finding represents a potential secret detected in file content
+type Finding struct {
+	Line    int    // 1-based line number
+	Rule    string // Which detection rule matched
+	Preview string // Redacted preview of the match, safe to display
+}
+
+// rule is a single secret-detection pattern. Rules with minEntropy > 0
+// additionally require the captured value to look random, which filters
+// out placeholders like "your-api-key-here".
+type rule struct {
+	name       string
+	pattern    *regexp.Regexp
+	minEntropy float64
+}
+
+// privateKeyHeader flags the opening line of a PEM private key; the key
+// material itself spans the following lines, which privateKeyBlock covers
+var (
+	privateKeyHeader = regexp.MustCompile(`-----BEGIN (?:RSA |EC |DSA |OPENSSH )?PRIVATE KEY-----`)
+	privateKeyBlock  = regexp.MustCompile(`(?s)-----BEGIN (?:RSA |EC |DSA |OPENSSH )?PRIVATE KEY-----.*?-----END (?:RSA |EC |DSA |OPENSSH )?PRIVATE KEY-----`)
+)
+
+var rules = []rule{
+	{name: "AWS access key", pattern: regexp.MustCompile(`\bAKIA[0-9A-Z]{16}\b`)},
+	{name: "GitHub token", pattern: regexp.MustCompile(`\b(?:ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36,}\b|\bgithub_pat_[A-Za-z0-9_]{22,}\b`)},
+	{name: "Slack token", pattern: regexp.MustCompile(`\bxox[baprs]-[A-Za-z0-9-]{10,}\b`)},
+	{name: "Google API key", pattern: regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`)},
+	{name: "Stripe secret key", pattern: regexp.MustCompile(`\b[sr]k_live_[A-Za-z0-9]{20,}\b`)},
+	{name: "Private key", pattern: privateKeyHeader},
+	{name: "Hardcoded credential", pattern: regexp.MustCompile(`(?i)(?:api[_-]?key|secret|token|passwd|password)["']?\s*[:=]\s*["']([A-Za-z0-9+/_\-]{16,})["']`), minEntropy: 3.5},
+}
+
+// redactedPlaceholder replaces secret values in redacted content
+const redactedPlaceholder = "[REDACTED]"
+
+// Scan detects potential secrets in file content and returns one finding
+// per match, with only a redacted preview retained
+func Scan(content string) []Finding {
+	var findings []Finding
+	for lineNum, line := range strings.Split(content, "\n") {
+		for _, r := range rules {
+			match := r.pattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+
+			// Entropy-gated rules check the captured value; others
+			// check the whole match
+			value := match[0]
+			if len(match) > 1 && match[1] != "" {
+				value = match[1]
+			}
+			if r.minEntropy > 0 && shannonEntropy(value) < r.minEntropy {
+				continue
+			}
+
+			findings = append(findings, Finding{
+				Line:    lineNum + 1,
+				Rule:    r.name,
+				Preview: redactValue(value),
+			})
+			break // One finding per line is enough
+		}
+	}
+	return findings
+}
+
+// Redact replaces every detected secret value in content with a
+// placeholder, returning the sanitized content and how many replacements
+// were made. Used to keep secrets out of prompts sent to the AI provider.
+func Redact(content string) (string, int) {
+	// Private keys span many lines: the header rule only flags the BEGIN
+	// line, so redact the whole PEM block first or the base64 key material
+	// on the following lines would be sent as-is
+	content, redacted := redactPrivateKeyBlocks(content)
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		for _, r := range rules {
+			match := r.pattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+
+			value := match[0]
+			if len(match) > 1 && match[1] != "" {
+				value = match[1]
+			}
+			if r.minEntropy > 0 && shannonEntropy(value) < r.minEntropy {
+				continue
+			}
+
+			lines[i] = strings.ReplaceAll(lines[i], value, redactedPlaceholder)
+			redacted++
+		}
+	}
+	if redacted == 0 {
+		return content, 0
+	}
+	return strings.Join(lines, "\n"), redacted
+}
+
+// redactPrivateKeyBlocks replaces every PEM private-key block, BEGIN
+// through END marker, with the placeholder. A truncated block (BEGIN
+// without a matching END) still exposes key material, so everything from
+// its header onward is redacted too.
+func redactPrivateKeyBlocks(content string) (string, int) {
+	count := len(privateKeyBlock.FindAllStringIndex(content, -1))
+	if count > 0 {
+		content = privateKeyBlock.ReplaceAllString(content, redactedPlaceholder)
+	}
+	if loc := privateKeyHeader.FindStringIndex(content); loc != nil {
+		content = content[:loc[0]] + redactedPlaceholder
+		count++
+	}
+	return content, count
+}
+
+// redactValue keeps just enough of a secret to recognize it (first and
+// last two characters) while making the preview safe to print and store
+func redactValue(value string) string {
+	if len(value) <= 8 {
+		return redactedPlaceholder
+	}
+	return fmt.Sprintf("%s…%s (%d chars)", value[:2], value[len(value)-2:], len(value))
+}
+
+// shannonEntropy measures the randomness of a string in bits per
+// character; genuine credentials score noticeably higher than words
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+
+	entropy := 0.0
+	length := float64(len(s))
+	for _, count := range counts {
+		p := float64(count) / length
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,21 @@
+package source
+
+import (
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// GitSource feeds the reviewer from git staged changes. It is the default
+// source and simply delegates to internal/git.
+type GitSource struct{}
+
+// Name identifies this source
+func (s *GitSource) Name() string { return "git" }
+
+// Available reports whether the current directory is a git repository
+func (s *GitSource) Available() bool { return git.IsGitRepo() }
+
+// Root returns the git repository root
+func (s *GitSource) Root() (string, error) { return git.GetRepoRoot() }
+
+// Changes returns the staged file changes
+func (s *GitSource) Changes() ([]git.FileChange, error) { return git.GetStagedChanges() }
@@ -0,0 +1,76 @@
+package source
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// SnapshotSource feeds the reviewer every file under a directory, treating
+// the whole tree as newly added. Useful for reviewing code that lives
+// outside any version control (exports, generated trees, vendored drops).
+type SnapshotSource struct {
+	Dir string
+}
+
+// Name identifies this source
+func (s *SnapshotSource) Name() string { return "snapshot" }
+
+// Available reports whether the snapshot directory exists
+func (s *SnapshotSource) Available() bool {
+	info, err := os.Stat(s.Dir)
+	return err == nil && info.IsDir()
+}
+
+// Root returns the snapshot directory
+func (s *SnapshotSource) Root() (string, error) {
+	return filepath.Abs(s.Dir)
+}
+
+// Changes walks the directory and returns every regular file as an added
+// change with full content and no diff
+func (s *SnapshotSource) Changes() ([]git.FileChange, error) {
+	var changes []git.FileChange
+
+	err := filepath.WalkDir(s.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			// Skip hidden directories like .git, .svn
+			if strings.HasPrefix(d.Name(), ".") && path != s.Dir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(s.Dir, path)
+		if err != nil {
+			relPath = path
+		}
+
+		content, err := git.ReadFileContent(path)
+		if err != nil {
+			return nil // Unreadable files are skipped, not fatal
+		}
+
+		changes = append(changes, git.FileChange{
+			Path:     filepath.ToSlash(relPath),
+			Status:   "A",
+			Content:  content,
+			IsBinary: strings.ContainsRune(content, '\x00'),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,41 @@
+// Package source abstracts where pending changes come from, so the
+// Reviewer can be fed from git staged changes, an SVN working copy, or a
+// plain filesystem snapshot without knowing the difference.
+package source
+
+import (
+	"fmt"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// ChangeSource provides pending changes to review
+type ChangeSource interface {
+	// Name identifies the source, e.g. "git", "svn", "snapshot"
+	Name() string
+	// Available reports whether the source can operate in the current directory
+	Available() bool
+	// Root returns the root directory of the working copy or snapshot
+	Root() (string, error)
+	// Changes collects the pending file changes to review
+	Changes() ([]git.FileChange, error)
+}
+
+// ForName returns the change source for a configured name. The optional
+// arg carries source-specific configuration (the directory for snapshot).
+func ForName(name string, arg string) (ChangeSource, error) {
+	switch name {
+	case "", "git":
+		return &GitSource{}, nil
+	case "svn":
+		return &SVNSource{}, nil
+	case "snapshot":
+		dir := arg
+		if dir == "" {
+			dir = "."
+		}
+		return &SnapshotSource{Dir: dir}, nil
+	default:
+		return nil, fmt.Errorf("unknown change source %q (valid: git, svn, snapshot)", name)
+	}
+}
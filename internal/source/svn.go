@@ -0,0 +1,82 @@
+package source
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/emilushi/prereview/internal/git"
+)
+
+// SVNSource feeds the reviewer from a Subversion working copy, treating
+// all locally modified/added files as the pending change set.
+type SVNSource struct{}
+
+// Name identifies this source
+func (s *SVNSource) Name() string { return "svn" }
+
+// Available reports whether the current directory is an SVN working copy
+func (s *SVNSource) Available() bool {
+	if _, err := exec.LookPath("svn"); err != nil {
+		return false
+	}
+	cmd := exec.Command("svn", "info")
+	return cmd.Run() == nil
+}
+
+// Root returns the working copy root
+func (s *SVNSource) Root() (string, error) {
+	cmd := exec.Command("svn", "info", "--show-item", "wc-root")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("svn info failed: %w: %s", err, output)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// Changes returns the locally modified and added files
+func (s *SVNSource) Changes() ([]git.FileChange, error) {
+	cmd := exec.Command("svn", "status")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get svn status: %w", err)
+	}
+
+	var changes []git.FileChange
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if len(line) < 8 {
+			continue
+		}
+
+		// First column: A=added, M=modified, D=deleted, ?=unversioned
+		status := string(line[0])
+		path := strings.TrimSpace(line[7:])
+
+		switch status {
+		case "A", "M", "R":
+		default:
+			continue
+		}
+
+		change := git.FileChange{
+			Path:   filepath.ToSlash(path),
+			Status: status,
+		}
+
+		content, err := git.ReadFileContent(path)
+		if err != nil {
+			continue
+		}
+		change.Content = content
+
+		// svn diff of the single file against BASE
+		if diffOut, err := exec.Command("svn", "diff", "--", path).Output(); err == nil {
+			change.Diff = string(diffOut)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
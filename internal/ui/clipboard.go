@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// clipboardCommands lists the writers tried in order for the current
+// platform; Wayland and X11 each need their own tool, so Linux tries both
+func clipboardCommands() [][]string {
+	switch runtime.GOOS {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		return [][]string{{"clip"}}
+	default:
+		return [][]string{
+			{"wl-copy"},
+			{"xclip", "-selection", "clipboard"},
+			{"xsel", "--clipboard", "--input"},
+		}
+	}
+}
+
+// copyToClipboard puts text on the system clipboard via the platform's
+// clipboard writer
+func copyToClipboard(text string) error {
+	var lastErr error
+	for _, argv := range clipboardCommands() {
+		if _, err := exec.LookPath(argv[0]); err != nil {
+			lastErr = err
+			continue
+		}
+		cmd := exec.Command(argv[0], argv[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			lastErr = fmt.Errorf("%s: %w", argv[0], err)
+			continue
+		}
+		return nil
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no clipboard tool found")
+	}
+	return fmt.Errorf("could not access clipboard: %w", lastErr)
+}
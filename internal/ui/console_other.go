@@ -0,0 +1,7 @@
+//go:build !windows
+
+package ui
+
+// enableConsoleUTF8 is a no-op outside Windows, where terminals speak
+// UTF-8 already
+func enableConsoleUTF8() bool { return true }
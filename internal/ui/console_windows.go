@@ -0,0 +1,16 @@
+//go:build windows
+
+package ui
+
+import "syscall"
+
+// enableConsoleUTF8 switches the console output code page to UTF-8 so the
+// UI's glyphs render properly instead of as mojibake. Returns whether the
+// switch took effect; when it doesn't (legacy consoles), the ASCII
+// fallback kicks in.
+func enableConsoleUTF8() bool {
+	const cpUTF8 = 65001
+	kernel32 := syscall.NewLazyDLL("kernel32.dll")
+	ret, _, _ := kernel32.NewProc("SetConsoleOutputCP").Call(cpUTF8)
+	return ret != 0
+}
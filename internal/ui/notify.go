@@ -0,0 +1,48 @@
+package ui
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Notify fires a best-effort desktop notification through the platform's
+// notifier: osascript on macOS, notify-send on Linux/BSD, and a PowerShell
+// toast on Windows. Errors are returned for verbose logging but a missing
+// notifier should never fail the review itself.
+func Notify(title, message string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", message, title)
+		return exec.Command("osascript", "-e", script).Run()
+	case "windows":
+		return notifyWindows(title, message)
+	default:
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return fmt.Errorf("notify-send not found: %w", err)
+		}
+		return exec.Command("notify-send", "--app-name=prereview", title, message).Run()
+	}
+}
+
+// notifyWindows raises a toast via the WinRT notification API, which needs
+// no third-party module - just PowerShell
+func notifyWindows(title, message string) error {
+	script := fmt.Sprintf(`
+[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] | Out-Null
+[Windows.Data.Xml.Dom.XmlDocument, Windows.Data.Xml.Dom.XmlDocument, ContentType = WindowsRuntime] | Out-Null
+$template = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)
+$texts = $template.GetElementsByTagName('text')
+$texts.Item(0).AppendChild($template.CreateTextNode('%s')) | Out-Null
+$texts.Item(1).AppendChild($template.CreateTextNode('%s')) | Out-Null
+$toast = [Windows.UI.Notifications.ToastNotification]::new($template)
+[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('prereview').Show($toast)`,
+		escapePowerShell(title), escapePowerShell(message))
+	return exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script).Run()
+}
+
+// escapePowerShell makes text safe inside a single-quoted PowerShell string
+func escapePowerShell(text string) string {
+	return strings.ReplaceAll(text, "'", "''")
+}
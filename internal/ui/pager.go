@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// pageThreshold is the line count above which output is handed to the
+// pager instead of scrolling past
+const pageThreshold = 40
+
+// Page shows content through the user's pager when it is long and a
+// terminal is attached; short content and non-terminal runs print
+// directly. $PAGER takes precedence, with `less -RFX` as the fallback so
+// colors pass through raw and short content exits immediately.
+func Page(content string) {
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	if plainMode || !IsInteractive() || strings.Count(content, "\n") <= pageThreshold {
+		fmt.Print(content)
+		return
+	}
+
+	name := "less"
+	args := []string{"-R", "-F", "-X"}
+	if pager := os.Getenv("PAGER"); pager != "" {
+		fields := strings.Fields(pager)
+		name = fields[0]
+		args = fields[1:]
+	}
+
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Print(content)
+	}
+}
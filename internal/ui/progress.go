@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// spinnerFrames cycle on the progress line while a model call is in flight
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// asciiSpinnerFrames replace the braille spinner on ASCII-only consoles
+var asciiSpinnerFrames = []string{"|", "/", "-", "\\"}
+
+// progressBarWidth is the character width of the file progress bar
+const progressBarWidth = 20
+
+// ReviewProgress renders review progress as one continuously updated
+// terminal line: a spinner while the current model call is in flight, a bar
+// across the files of the pass, and elapsed/ETA once at least one file has
+// finished. It implements review.ProgressDisplay and is only installed for
+// interactive terminal runs; everything else keeps the plain line-per-file
+// output.
+type ReviewProgress struct {
+	mu     sync.Mutex
+	start  time.Time
+	index  int // 1-based index of the file in flight
+	total  int
+	path   string
+	status string // Live receiving status for the in-flight request
+	frame  int
+	stop   chan struct{}
+}
+
+// NewReviewProgress creates a progress display for one review pass
+func NewReviewProgress() *ReviewProgress {
+	return &ReviewProgress{start: time.Now()}
+}
+
+// StartFile begins the progress line for the next file
+func (p *ReviewProgress) StartFile(index, total int, path string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stopSpinnerLocked()
+	p.index, p.total, p.path = index, total, path
+	p.status = ""
+	p.redrawLocked()
+
+	p.stop = make(chan struct{})
+	go p.spin(p.stop)
+}
+
+// Note prints a remark above the progress line without breaking it
+func (p *ReviewProgress) Note(text string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fmt.Print("\r\033[K    " + text + "\n")
+	p.redrawLocked()
+}
+
+// Streaming folds the request's live receiving status into the progress
+// line, so the spinner and the streaming callback never repaint the same
+// line independently
+func (p *ReviewProgress) Streaming(status string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.status = status
+	p.redrawLocked()
+}
+
+// FinishFile replaces the progress line with the file's outcome (silently
+// for clean files, to keep long runs compact)
+func (p *ReviewProgress) FinishFile(findings int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stopSpinnerLocked()
+	p.status = ""
+	fmt.Print("\r\033[K")
+	if findings > 0 {
+		fmt.Printf("  %s %s - %d suggestion(s)\n", render(warningStyle, "●"), p.path, findings)
+	}
+}
+
+// Finish clears the progress line once the pass is over
+func (p *ReviewProgress) Finish() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stopSpinnerLocked()
+	fmt.Print("\r\033[K")
+}
+
+// spin advances the spinner until stopped
+func (p *ReviewProgress) spin(stop chan struct{}) {
+	ticker := time.NewTicker(120 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			p.frame++
+			p.redrawLocked()
+			p.mu.Unlock()
+		}
+	}
+}
+
+// stopSpinnerLocked stops the spinner goroutine if one is running
+func (p *ReviewProgress) stopSpinnerLocked() {
+	if p.stop != nil {
+		close(p.stop)
+		p.stop = nil
+	}
+}
+
+// redrawLocked repaints the progress line in place
+func (p *ReviewProgress) redrawLocked() {
+	if p.total == 0 {
+		return
+	}
+
+	done := p.index - 1
+	elapsed := time.Since(p.start)
+	timing := formatClock(elapsed) + " elapsed"
+	if done > 0 && done < p.total {
+		eta := elapsed / time.Duration(done) * time.Duration(p.total-done)
+		timing += ", ~" + formatClock(eta) + " left"
+	}
+
+	frames := spinnerFrames
+	if asciiMode {
+		frames = asciiSpinnerFrames
+	}
+	frame := frames[p.frame%len(frames)]
+	line := fmt.Sprintf("  %s %s %d/%d %s (%s)",
+		render(infoStyle, frame), progressBar(done, p.total), p.index, p.total, p.path, timing)
+	if p.status != "" {
+		line += " " + render(mutedStyle, p.status)
+	}
+	fmt.Print("\r\033[K" + line)
+}
+
+// progressBar renders the across-files bar
+func progressBar(done, total int) string {
+	if total < 1 {
+		total = 1
+	}
+	filled := done * progressBarWidth / total
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+	return render(mutedStyle, bar)
+}
+
+// formatClock renders a duration as m:ss
+func formatClock(d time.Duration) string {
+	seconds := int(d.Round(time.Second).Seconds())
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/emilushi/prereview/internal/diffutil"
+	"github.com/emilushi/prereview/internal/git"
 	"github.com/emilushi/prereview/internal/review"
 )
 
@@ -22,9 +27,10 @@ const (
 
 // SessionOutcome represents the result of a review session
 type SessionOutcome struct {
-	Action  Action
-	Fixed   int
-	Skipped int
+	Action        Action
+	Fixed         int
+	Skipped       int
+	ModifiedFiles []string // Files changed by applied fixes, for targeted re-review
 }
 
 // ReviewSession handles an interactive review session
@@ -35,17 +41,189 @@ type ReviewSession struct {
 	skipped     int
 	suggestions []review.Suggestion
 	skippedMap  map[int]bool
+	fixedMap    map[int]bool // Indices whose fix was applied, so back-navigation can undo the tally
+	decided     map[int]bool // Indices settled ahead of current by a bulk action
+	verbose     bool
+	filter      sessionFilter           // Active triage filter, zero value = show everything
+	sortMode    string                  // Active sort order, "" = model order
+	editorCmd   string                  // o(pen) command template (editor_command), "" = $EDITOR
+	keys        map[string]string       // Action name -> bound key, seeded with the defaults
+	snapshots   map[string]fileSnapshot // Pre-fix file contents, for rollback
+	explainer   Explainer               // Answers follow-up questions about a suggestion
+	verifier    Verifier                // Sanity-checks applied fixes before staging
+	feedback    FeedbackSink            // Records useful / false-positive votes, nil = disabled
+	stateDir    string                  // Where progress is persisted for `prereview resume`, "" = disabled
+	exportPath  string                  // Standalone session export file, "" = disabled
+}
+
+// Explainer answers a typed follow-up question about a suggestion, turning
+// a finding into a conversation with the model
+type Explainer func(sug review.Suggestion, question string) (string, error)
+
+// Verifier sanity-checks an applied fix given the patched region, returning
+// a short note describing any new problem (empty means the fix looks clean)
+type Verifier func(sug review.Suggestion, patched string) (string, error)
+
+// FeedbackSink persists a vote on whether a finding was valid
+type FeedbackSink func(sug review.Suggestion, useful bool)
+
+// fileSnapshot preserves a file's state before the session modified it
+type fileSnapshot struct {
+	content string
+	perm    os.FileMode
 }
 
 // NewReviewSession creates a new review session
-func NewReviewSession(result *review.ReviewResult) *ReviewSession {
+func NewReviewSession(result *review.ReviewResult, verbose bool) *ReviewSession {
 	return &ReviewSession{
 		result:      result,
 		suggestions: result.Suggestions,
 		skippedMap:  make(map[int]bool),
+		fixedMap:    make(map[int]bool),
+		decided:     make(map[int]bool),
+		verbose:     verbose,
+		snapshots:   make(map[string]fileSnapshot),
+		keys:        defaultKeybindings(),
+	}
+}
+
+// defaultKeybindings maps each session action to its default key; the
+// keybindings config section overrides individual entries
+func defaultKeybindings() map[string]string {
+	return map[string]string{
+		"fix":     "f",
+		"edit":    "e",
+		"preview": "p",
+		"skip":    "s",
+		"view":    "v",
+		"open":    "o",
+		"copy":    "c",
+		"explain": "x",
+		"useful":  "+",
+		"fp":      "-",
+		"back":    "b",
+		"next":    "n",
+		"jump":    "g",
+		"quit":    "q",
+		"help":    "?",
 	}
 }
 
+// SetKeybindings applies key overrides from the keybindings config
+// section (action name -> key). Unknown actions are reported rather than
+// silently dropped, so typos in the config surface.
+func (s *ReviewSession) SetKeybindings(overrides map[string]string) {
+	for action, key := range overrides {
+		action = strings.ToLower(strings.TrimSpace(action))
+		key = strings.ToLower(strings.TrimSpace(key))
+		if _, ok := s.keys[action]; !ok {
+			Warning(fmt.Sprintf("Unknown action in keybindings: %q", action))
+			continue
+		}
+		if key != "" {
+			s.keys[action] = key
+		}
+	}
+}
+
+// key returns the key currently bound to an action
+func (s *ReviewSession) key(action string) string {
+	return s.keys[action]
+}
+
+// opt renders an action for the prompt line, highlighting its bound key
+// inside the label when it still matches ("[f]ix"), or ahead of it after
+// a remap ("[a] fix")
+func (s *ReviewSession) opt(action, label string) string {
+	key := s.key(action)
+	if rest, ok := strings.CutPrefix(label, key); ok {
+		return Option(key) + rest
+	}
+	return Option(key) + " " + label
+}
+
+// printHelp shows the `?` overlay: every session action with its current
+// key (so remaps show up), plus the word commands that take arguments
+func (s *ReviewSession) printHelp() {
+	rows := []struct{ action, desc string }{
+		{"fix", "Apply the suggested fix and stage it"},
+		{"edit", "Adjust the suggested fix in $EDITOR before applying"},
+		{"preview", "Show the exact patch applying the fix would make"},
+		{"skip", "Skip this suggestion"},
+		{"view", "Show the staged diff for this file"},
+		{"open", "Open the file in your editor at the flagged line"},
+		{"copy", "Copy the suggested fix to the clipboard (" + s.key("copy") + "m: as markdown)"},
+		{"explain", "Ask the reviewer a follow-up question"},
+		{"useful", "Record this finding as useful"},
+		{"fp", "Record this finding as a false positive and skip it"},
+		{"back", "Go back to the previous suggestion"},
+		{"next", "Defer this suggestion without deciding"},
+		{"jump", "Jump to suggestion <n> (" + s.key("jump") + " 12, or just 12)"},
+		{"quit", "Abort the session"},
+		{"help", "Show this help"},
+	}
+
+	fmt.Println()
+	Info("  Session actions:")
+	for _, row := range rows {
+		fmt.Printf("    %-3s %-9s %s\n", asciiFold(s.key(row.action)), row.action, row.desc)
+	}
+	fmt.Println()
+	Info("  Word commands:")
+	fmt.Println("    A [category]     Apply all remaining high-confidence fixes")
+	fmt.Println("    S [category]     Skip all remaining suggestions")
+	fmt.Println("    filter key=val   Narrow by severity=, category=, file= (filter clear resets)")
+	fmt.Println("    sort <order>     Order by severity, file, or confidence")
+	fmt.Println()
+	Muted("  Keys can be remapped in .prereviewrc.yaml under keybindings:, e.g. keybindings: {fix: a}")
+}
+
+// canonical translates a typed key into its action name; full action
+// names always work regardless of the bindings
+func (s *ReviewSession) canonical(input string) string {
+	if input == s.key("copy")+"m" {
+		return "copy md"
+	}
+	for action, key := range s.keys {
+		if input == key {
+			return action
+		}
+	}
+	return input
+}
+
+// Apply applies a single suggestion's fix non-interactively, for callers
+// like `prereview apply` that operate outside a session. Fixes that would
+// modify lines outside the reported range are rejected rather than
+// confirmed.
+func (s *ReviewSession) Apply(sug review.Suggestion) bool {
+	return s.applyFix(sug, nil)
+}
+
+// SetExplainer installs the callback used by the e(xplain) action to ask
+// the model follow-up questions about a suggestion
+func (s *ReviewSession) SetExplainer(explainer Explainer) {
+	s.explainer = explainer
+}
+
+// SetVerifier installs the callback that sanity-checks each applied fix
+// before it is staged (the verify_fixes setting)
+func (s *ReviewSession) SetVerifier(verifier Verifier) {
+	s.verifier = verifier
+}
+
+// SetFeedbackSink installs the callback that persists useful /
+// false-positive votes, enabling the +/- session actions
+func (s *ReviewSession) SetFeedbackSink(sink FeedbackSink) {
+	s.feedback = sink
+}
+
+// SetEditorCommand sets the o(pen) command template (the editor_command
+// setting); {file} and {line} are replaced with the flagged location
+func (s *ReviewSession) SetEditorCommand(template string) {
+	s.editorCmd = template
+}
+
 // Run starts the interactive review session
 func (s *ReviewSession) Run() SessionOutcome {
 	total := len(s.suggestions)
@@ -56,14 +234,61 @@ func (s *ReviewSession) Run() SessionOutcome {
 
 	reader := bufio.NewReader(os.Stdin)
 
+	// With findings spread across several files, start from a file-level
+	// overview instead of a flat walk over the whole list
+	if len(s.fileGroups()) > 1 {
+		if outcome := s.overview(reader); outcome != nil {
+			return *outcome
+		}
+	} else if outcome := s.walk(reader); outcome != nil {
+		return *outcome
+	}
+
+	return s.finish(reader)
+}
+
+// walk steps through the suggestions the active filter admits, one
+// decision at a time. It returns a non-nil outcome when the user quits
+// mid-walk and nil once it reaches the end of the list.
+func (s *ReviewSession) walk(reader *bufio.Reader) *SessionOutcome {
+	total := len(s.suggestions)
+
 	for s.current < total {
+		// Bulk actions and earlier passes (re-entering from the overview)
+		// may have settled suggestions ahead of the cursor
+		if s.decided[s.current] || s.skippedMap[s.current] || s.fixedMap[s.current] {
+			s.current++
+			continue
+		}
+
+		// Suggestions outside the active filter stay undecided
+		if !s.matchesFilter(s.suggestions[s.current]) {
+			s.current++
+			continue
+		}
+
 		suggestion := s.suggestions[s.current]
 
+		// Status bar showing the active filter and sort order
+		if bar := s.statusBar(); bar != "" {
+			Muted("  " + bar)
+		}
+
 		// Print suggestion
 		s.printSuggestion(suggestion, s.current+1, total)
 
 		// Get user input
-		fmt.Print("\n  " + Option("f") + "ix | " + Option("s") + "kip | " + Option("v") + "iew diff | " + Option("q") + "uit: ")
+		actions := "\n  " + s.opt("fix", "fix") + " | " + s.opt("edit", "edit") + " | " + s.opt("preview", "preview") + " | " + s.opt("skip", "skip") + " | " + s.opt("view", "view diff") + " | " + s.opt("open", "open")
+		if s.explainer != nil {
+			actions += " | " + s.opt("explain", "explain")
+		}
+		actions += " | " + s.opt("copy", "copy") + " | " + s.opt("quit", "quit")
+		actions += "\n  " + s.opt("back", "back") + " | " + s.opt("next", "next") + " | " + Option(s.key("jump")) + " <n> jump | " + Option("A") + "pply all high-confidence | " + Option("S") + "kip all"
+		if s.feedback != nil {
+			actions += " | " + s.opt("useful", "useful") + " | " + s.opt("fp", "false positive")
+		}
+		actions += " | " + s.opt("help", "help")
+		fmt.Print(actions + ": ")
 
 		input, err := reader.ReadString('\n')
 		if err != nil {
@@ -72,12 +297,54 @@ func (s *ReviewSession) Run() SessionOutcome {
 			continue
 		}
 
-		input = strings.TrimSpace(strings.ToLower(input))
+		input = strings.TrimSpace(input)
+
+		// Bulk actions are uppercase on purpose, so a slipped f/s keystroke
+		// can never settle the whole remaining list
+		if action, category, ok := parseBulkAction(input); ok {
+			switch action {
+			case "A":
+				s.bulkApply(category)
+			case "S":
+				s.bulkSkip(category)
+			}
+			s.saveState()
+			fmt.Println()
+			continue
+		}
+
+		input = strings.ToLower(input)
+
+		// Triage commands: narrow or reorder what the session walks through
+		if args, ok := strings.CutPrefix(input, "filter"); ok && (args == "" || args[0] == ' ') {
+			s.setFilter(strings.TrimSpace(args))
+			fmt.Println()
+			continue
+		}
+		if args, ok := strings.CutPrefix(input, "sort"); ok && (args == "" || args[0] == ' ') {
+			s.sortSuggestions(strings.TrimSpace(args))
+			fmt.Println()
+			continue
+		}
+
+		// Jump to a specific suggestion by number ("g 12" or just "12")
+		if idx, ok := s.parseJump(input); ok {
+			if idx < 1 || idx > total {
+				Muted(fmt.Sprintf("  There is no suggestion %d (1-%d).", idx, total))
+				continue
+			}
+			s.current = idx - 1
+			s.undoDecision(s.current)
+			s.saveState()
+			fmt.Println()
+			continue
+		}
 
-		switch input {
-		case "f", "fix":
-			if s.applyFix(suggestion) {
+		switch s.canonical(input) {
+		case "fix":
+			if s.applyFix(suggestion, reader) {
 				s.fixed++
+				s.fixedMap[s.current] = true
 				Success("  ✓ Applied fix")
 			} else {
 				Warning("  ⚠ Could not apply fix automatically")
@@ -91,36 +358,124 @@ func (s *ReviewSession) Run() SessionOutcome {
 				}
 			}
 			s.current++
+			s.saveState()
 
-		case "s", "skip":
+		case "skip":
 			s.skipped++
 			s.skippedMap[s.current] = true
 			Muted("  ⏭ Skipped")
 			s.current++
+			s.saveState()
 
-		case "v", "view":
+		case "back":
+			if s.current == 0 {
+				Muted("  Already at the first suggestion.")
+				continue
+			}
+			s.current--
+			s.undoDecision(s.current)
+			s.saveState()
+
+		case "next":
+			Muted("  ⏩ Deferred - it stays undecided unless you come back to it")
+			s.current++
+			s.saveState()
+
+		case "preview":
+			s.previewFix(suggestion)
+			// Don't advance, let user decide after seeing the patch
+
+		case "open":
+			s.openInEditor(suggestion)
+			// Don't advance, let user decide after looking around
+
+		case "view":
 			s.viewDiff(suggestion)
 			// Don't advance, let user decide
 
-		case "q", "quit":
-			return SessionOutcome{
+		case "edit":
+			if s.editFix(suggestion, reader) {
+				s.fixed++
+				s.fixedMap[s.current] = true
+				Success("  ✓ Applied edited fix")
+				s.current++
+				s.saveState()
+			}
+			// On cancel or failure, stay on the current suggestion
+
+		case "copy":
+			s.copySuggestion(suggestion, false)
+			// Don't advance, copying is not a decision
+
+		case "copy md":
+			s.copySuggestion(suggestion, true)
+			// Don't advance, copying is not a decision
+
+		case "explain":
+			if s.explainer == nil {
+				Muted("  Explain is not available in this session.")
+				continue
+			}
+			s.explain(suggestion, reader)
+			// Don't advance, let user decide after reading the answer
+
+		case "useful":
+			if s.feedback == nil {
+				Muted("  Feedback recording is not available in this session.")
+				continue
+			}
+			s.feedback(suggestion, true)
+			Muted("  Noted as useful - decide with f/e/s.")
+			// Don't advance, the finding still needs a decision
+
+		case "fp", "false positive":
+			if s.feedback == nil {
+				Muted("  Feedback recording is not available in this session.")
+				continue
+			}
+			s.feedback(suggestion, false)
+			s.skipped++
+			s.skippedMap[s.current] = true
+			Muted("  ⏭ Noted as false positive and skipped")
+			s.current++
+			s.saveState()
+
+		case "help":
+			s.printHelp()
+			// Don't advance
+
+		case "quit":
+			return &SessionOutcome{
 				Action:  ActionAbort,
 				Fixed:   s.fixed,
 				Skipped: s.skipped,
 			}
 
 		default:
-			Muted("  Invalid option. Use f, s, v, or q.")
+			Muted(fmt.Sprintf("  Invalid option - press %s for a list of actions.", s.key("help")))
 		}
 
 		fmt.Println()
 	}
 
+	return nil
+}
+
+// finish closes out a session whose walk completed: the state file is
+// removed, the summary printed, and the user asked how to proceed
+func (s *ReviewSession) finish(reader *bufio.Reader) SessionOutcome {
+	// Every suggestion has a decision - the session can no longer be resumed
+	s.clearState()
+
 	// Show summary
 	s.printSummary()
 
-	// Ask what to do
-	fmt.Print("\nProceed with commit? " + Option("y") + "es | " + Option("n") + "o | " + Option("r") + "e-review: ")
+	// Ask what to do; rollback is only offered once fixes touched files
+	prompt := "\nProceed with commit? " + Option("y") + "es | " + Option("n") + "o | " + Option("r") + "e-review"
+	if len(s.snapshots) > 0 {
+		prompt += " | roll" + Option("b") + "ack"
+	}
+	fmt.Print(prompt + ": ")
 	input, _ := reader.ReadString('\n')
 	input = strings.TrimSpace(strings.ToLower(input))
 
@@ -133,8 +488,15 @@ func (s *ReviewSession) Run() SessionOutcome {
 		}
 	case "r", "re-review":
 		return SessionOutcome{
-			Action:  ActionReReview,
-			Fixed:   s.fixed,
+			Action:        ActionReReview,
+			Fixed:         s.fixed,
+			Skipped:       s.skipped,
+			ModifiedFiles: s.modifiedFiles(),
+		}
+	case "b", "rollback":
+		s.rollback()
+		return SessionOutcome{
+			Action:  ActionAbort,
 			Skipped: s.skipped,
 		}
 	default:
@@ -146,81 +508,199 @@ func (s *ReviewSession) Run() SessionOutcome {
 	}
 }
 
+// Report prints every suggestion and the summary without prompting,
+// for non-TTY invocations where an interactive session would hang
+func (s *ReviewSession) Report() {
+	total := len(s.suggestions)
+	for i, suggestion := range s.suggestions {
+		s.printSuggestion(suggestion, i+1, total)
+		fmt.Println()
+	}
+	s.printSummary()
+}
+
 // printSuggestion displays a suggestion
 func (s *ReviewSession) printSuggestion(sug review.Suggestion, num, total int) {
 	Divider()
 
-	// Header
-	headerStyle := lipgloss.NewStyle().
-		Bold(true).
-		Background(lipgloss.Color("#374151")).
-		Foreground(lipgloss.Color("#F9FAFB")).
-		Padding(0, 1)
-
-	header := fmt.Sprintf("📄 %s [%d/%d]", sug.File, num, total)
-	fmt.Println(headerStyle.Render(header))
+	// Header; in accessible mode the position is spelled out in text
+	if accessibleMode {
+		fmt.Printf("SUGGESTION %d of %d: %s\n", num, total, sug.File)
+	} else {
+		header := fmt.Sprintf("📄 %s [%d/%d]", sug.File, num, total)
+		fmt.Println(render(sessionHeaderStyle, header))
+	}
 
 	Divider()
 
 	// Location
 	if sug.Line > 0 {
-		locationStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#9CA3AF"))
 		location := fmt.Sprintf("Line %d", sug.Line)
 		if sug.EndLine > sug.Line {
 			location = fmt.Sprintf("Lines %d-%d", sug.Line, sug.EndLine)
 		}
-		fmt.Println(locationStyle.Render("  " + location))
+		fmt.Println(render(secondaryStyle, "  "+location))
 	}
 
-	// Severity and title
-	sevStyle := SeverityStyle(string(sug.Severity))
-	icon := SeverityIcon(string(sug.Severity))
+	// Severity and title; the icon means nothing to a screen reader, so
+	// accessible mode uses the severity word itself
 	fmt.Println()
-	fmt.Println(sevStyle.Render("  " + icon + " " + sug.Title))
+	if accessibleMode {
+		fmt.Println(strings.ToUpper(string(sug.Severity)) + ": " + sug.Title)
+	} else {
+		sevStyle := SeverityStyle(string(sug.Severity))
+		icon := SeverityIcon(string(sug.Severity))
+		fmt.Println(sevStyle.Render("  " + icon + " " + sug.Title))
+	}
 
 	// Confidence indicator
 	if sug.Confidence != "" {
-		confStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#9CA3AF")).Italic(true)
 		confText := fmt.Sprintf("  Confidence: %s", sug.Confidence)
 		if sug.Confidence == "low" {
 			confText += " (may be false positive)"
 		}
-		fmt.Println(confStyle.Render(confText))
+		fmt.Println(render(secondaryStyle.Italic(true), confText))
 	}
 
 	// Description
 	if sug.Description != "" {
-		descStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#D1D5DB"))
 		fmt.Println()
-		fmt.Println(descStyle.Render("  " + sug.Description))
+		fmt.Println(render(textStyle, "  "+sug.Description))
 	}
 
-	// Suggested fix
-	if sug.SuggestFix != "" {
+	// The real staged code around the flagged range, so the finding can be
+	// judged against the source rather than the model's description alone
+	s.printContext(sug)
+
+	// Suggested fix, rendered as a unified diff against the original code
+	// when both sides are available so only the actual change stands out
+	if sug.SuggestFix != "" && sug.SuggestFix != "N/A" {
 		fmt.Println()
-		fixLabelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#10B981")).Bold(true)
-		fmt.Println(fixLabelStyle.Render("  Suggested fix:"))
-		codeBlockStyle := lipgloss.NewStyle().
-			Background(lipgloss.Color("#1F2937")).
-			Foreground(lipgloss.Color("#A7F3D0")).
-			Padding(0, 1).
-			MarginLeft(2)
-		fmt.Println(codeBlockStyle.Render(sug.SuggestFix))
+		fmt.Println(render(successStyle.Bold(true), "  Suggested fix:"))
+
+		unified := ""
+		if sug.OriginalCode != "" && sug.OriginalCode != "N/A" {
+			unified = diffutil.Unified(sug.OriginalCode, sug.SuggestFix)
+		}
+		if unified != "" {
+			printDiff(unified)
+		} else {
+			fmt.Println(render(sessionCodeStyle, sug.SuggestFix))
+		}
+	}
+
+	// Provenance and ID (verbose only): where the finding came from and
+	// how to refer to it with `prereview apply --suggestion <id>`
+	if s.verbose {
+		provStyle := mutedStyle.Italic(true)
+		if len(sug.Provenance) > 0 {
+			fmt.Println(render(provStyle, "  via: "+strings.Join(sug.Provenance, ", ")))
+		}
+		fmt.Println(render(provStyle, "  id: "+review.SuggestionID(sug)))
 	}
 
 	// Category badge
 	if sug.Category != "" {
-		badgeStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#9CA3AF")).
-			Background(lipgloss.Color("#374151")).
-			Padding(0, 1)
 		fmt.Println()
-		fmt.Println("  " + badgeStyle.Render(sug.Category))
+		fmt.Println("  " + render(badgeStyle, sug.Category))
 	}
 }
 
-// applyFix applies a suggested fix
-func (s *ReviewSession) applyFix(sug review.Suggestion) bool {
+// contextRadius is how many lines of surrounding source are shown on each
+// side of the flagged range
+const contextRadius = 3
+
+// printContext renders the staged file around the flagged range with line
+// numbers, marking the flagged lines, so validity can be judged without
+// leaving the terminal
+func (s *ReviewSession) printContext(sug review.Suggestion) {
+	if sug.Line <= 0 {
+		return
+	}
+	content, ok := stagedContent(sug.File)
+	if !ok {
+		return
+	}
+
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	start := sug.Line
+	end := start
+	if sug.EndLine > start {
+		end = sug.EndLine
+	}
+	if start > len(lines) {
+		return
+	}
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	from := start - contextRadius
+	if from < 1 {
+		from = 1
+	}
+	to := end + contextRadius
+	if to > len(lines) {
+		to = len(lines)
+	}
+
+	fmt.Println()
+	for i := from; i <= to; i++ {
+		line := lines[i-1]
+		if i >= start && i <= end {
+			fmt.Println("  " + render(contextFlagStyle, fmt.Sprintf("› %4d │ %s", i, line)))
+		} else {
+			fmt.Println("  " + render(mutedStyle, fmt.Sprintf("  %4d │ ", i)) + render(secondaryStyle, line))
+		}
+	}
+}
+
+// stagedContent reads the staged version of a file, falling back to the
+// working tree for sources that aren't in the index
+func stagedContent(file string) (string, bool) {
+	if out, err := exec.Command("git", "show", ":"+file).Output(); err == nil {
+		return string(out), true
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// diffString renders a unified diff with removals in red and additions in
+// green, indented to match the session layout
+func diffString(unified string) string {
+	var sb strings.Builder
+	for _, line := range strings.Split(unified, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			sb.WriteString("  " + render(diffHunkStyle, line) + "\n")
+		case strings.HasPrefix(line, "+"):
+			sb.WriteString("  " + render(diffAddStyle, line) + "\n")
+		case strings.HasPrefix(line, "-"):
+			sb.WriteString("  " + render(diffDelStyle, line) + "\n")
+		default:
+			sb.WriteString("  " + line + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// printDiff prints a unified diff inline (short fix diffs in suggestions)
+func printDiff(unified string) {
+	fmt.Print(diffString(unified))
+}
+
+// fixGuardSlack is how many lines a fix may extend beyond the reported
+// LINE..END_LINE range before it requires explicit confirmation
+const fixGuardSlack = 3
+
+// applyFix applies a suggested fix. When the fix would modify lines well
+// outside the reported range (a sign the model rewrote too much), the user
+// is asked to confirm before anything is written; with a nil reader such
+// fixes are rejected outright.
+func (s *ReviewSession) applyFix(sug review.Suggestion, reader *bufio.Reader) bool {
 	// Check if we have both original and fix code
 	if sug.SuggestFix == "" || sug.SuggestFix == "N/A" {
 		return false
@@ -228,40 +708,83 @@ func (s *ReviewSession) applyFix(sug review.Suggestion) bool {
 	if sug.OriginalCode == "" || sug.OriginalCode == "N/A" {
 		return false
 	}
+	// The original snippet was already checked against the staged content
+	if sug.Unfixable {
+		return false
+	}
+
+	// Never write outside the repository: a malformed response could carry
+	// an absolute or traversal path in Suggestion.File
+	path, ok := safeFixPath(sug.File)
+	if !ok {
+		Warning("  ⚠ Refusing to modify path outside the repository: " + sug.File)
+		return false
+	}
 
 	// Read the file
-	content, err := os.ReadFile(sug.File)
+	content, err := os.ReadFile(path)
 	if err != nil {
 		return false
 	}
 
 	fileContent := string(content)
 
-	// Try to find and replace the original code
-	if !strings.Contains(fileContent, sug.OriginalCode) {
+	// Locate and replace the original code, tolerating whitespace drift
+	newContent, ok := replaceOriginalCode(fileContent, sug)
+	if !ok || newContent == fileContent {
 		return false
 	}
 
-	// Replace the original code with the fix
-	newContent := strings.Replace(fileContent, sug.OriginalCode, sug.SuggestFix, 1)
-
-	// Check if replacement actually happened
-	if newContent == fileContent {
-		return false
+	// Guard rail: if the located region falls far outside the reported
+	// LINE..END_LINE range, the fix likely rewrites more than the finding
+	if start, end, located := fixSpan(fileContent, sug); located && sug.Line > 0 {
+		reportedEnd := sug.Line
+		if sug.EndLine > sug.Line {
+			reportedEnd = sug.EndLine
+		}
+		if start < sug.Line-fixGuardSlack || end > reportedEnd+fixGuardSlack {
+			Warning(fmt.Sprintf("  ⚠ Fix modifies lines %d-%d, outside the reported range (%d-%d)", start, end, sug.Line, reportedEnd))
+			if reader == nil {
+				return false
+			}
+			fmt.Print("  Apply anyway? [y/N]: ")
+			confirm, _ := reader.ReadString('\n')
+			if strings.TrimSpace(strings.ToLower(confirm)) != "y" {
+				return false
+			}
+		}
 	}
 
 	// Write the file back (preserving original permissions)
-	fileInfo, _ := os.Stat(sug.File)
+	fileInfo, _ := os.Stat(path)
 	perm := os.FileMode(0644)
 	if fileInfo != nil {
 		perm = fileInfo.Mode().Perm()
 	}
-	if err := os.WriteFile(sug.File, []byte(newContent), perm); err != nil {
+
+	// Snapshot the file before its first modification, so the whole
+	// session can be rolled back at the final prompt
+	firstTouch := false
+	if _, ok := s.snapshots[path]; !ok {
+		s.snapshots[path] = fileSnapshot{content: fileContent, perm: perm}
+		firstTouch = true
+	}
+
+	if err := os.WriteFile(path, []byte(newContent), perm); err != nil {
+		return false
+	}
+
+	// Optional follow-up check (verify_fixes): ask the model whether the
+	// applied fix introduces a new issue, and revert before staging if so
+	if s.verifier != nil && !s.verifyApplied(sug, fileContent, newContent, path, perm, reader) {
+		if firstTouch {
+			delete(s.snapshots, path)
+		}
 		return false
 	}
 
 	// Stage the change
-	cmd := exec.Command("git", "add", sug.File)
+	cmd := exec.Command("git", "add", path)
 	if err := cmd.Run(); err != nil {
 		// File was modified but not staged - still consider it a success
 		Warning("  File modified but could not stage: " + err.Error())
@@ -270,6 +793,848 @@ func (s *ReviewSession) applyFix(sug review.Suggestion) bool {
 	return true
 }
 
+// replaceOriginalCode locates the suggestion's original code in the file
+// content and replaces it with the fix. It tries an exact match first, then
+// a whitespace-normalized match, then an anchored line-range replacement
+// using the reported LINE/END_LINE, so tab/space mismatches in the model's
+// snippet don't cause the fix to fail.
+func replaceOriginalCode(fileContent string, sug review.Suggestion) (string, bool) {
+	// 1. Exact match
+	if strings.Contains(fileContent, sug.OriginalCode) {
+		return strings.Replace(fileContent, sug.OriginalCode, sug.SuggestFix, 1), true
+	}
+
+	// 2. Whitespace-normalized match: find the exact text in the file whose
+	// trimmed lines equal the trimmed snippet lines
+	if exact, ok := review.FindNormalizedMatch(fileContent, sug.OriginalCode); ok {
+		return strings.Replace(fileContent, exact, sug.SuggestFix, 1), true
+	}
+
+	// 3. Anchored line-range replacement using the reported location
+	return replaceLineRange(fileContent, sug)
+}
+
+// safeFixPath resolves a suggestion's file path and verifies it stays
+// inside the repository worktree. Returns the resolved path and whether
+// it is safe to modify.
+func safeFixPath(path string) (string, bool) {
+	repoRoot, err := git.GetRepoRoot()
+	if err != nil {
+		return "", false
+	}
+
+	resolved := path
+	if !filepath.IsAbs(resolved) {
+		resolved = filepath.Join(repoRoot, resolved)
+	}
+	resolved = filepath.Clean(resolved)
+
+	rel, err := filepath.Rel(repoRoot, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return resolved, true
+}
+
+// fixSpan reports the 1-based inclusive line range in fileContent that a
+// fix would replace, using the same match strategies as replaceOriginalCode
+func fixSpan(fileContent string, sug review.Suggestion) (start, end int, ok bool) {
+	matched := ""
+	if strings.Contains(fileContent, sug.OriginalCode) {
+		matched = sug.OriginalCode
+	} else if exact, found := review.FindNormalizedMatch(fileContent, sug.OriginalCode); found {
+		matched = exact
+	}
+
+	if matched != "" {
+		idx := strings.Index(fileContent, matched)
+		start = strings.Count(fileContent[:idx], "\n") + 1
+		end = start + strings.Count(matched, "\n")
+		return start, end, true
+	}
+
+	// Line-range replacement targets exactly the reported range
+	if sug.Line > 0 {
+		end = sug.Line
+		if sug.EndLine > sug.Line {
+			end = sug.EndLine
+		}
+		return sug.Line, end, true
+	}
+
+	return 0, 0, false
+}
+
+// replaceLineRange replaces the lines at the suggestion's reported
+// LINE..END_LINE range with the fix, after verifying the range still
+// loosely matches the original snippet
+func replaceLineRange(fileContent string, sug review.Suggestion) (string, bool) {
+	if sug.Line <= 0 {
+		return "", false
+	}
+
+	lines := strings.Split(fileContent, "\n")
+	start := sug.Line - 1
+	end := start
+	if sug.EndLine > sug.Line {
+		end = sug.EndLine - 1
+	}
+	if start >= len(lines) || end >= len(lines) {
+		return "", false
+	}
+
+	// Sanity check: the first non-empty original line should appear
+	// (whitespace-trimmed) somewhere in the target range, otherwise the
+	// reported location is unreliable and replacing would corrupt the file
+	anchor := ""
+	for _, line := range strings.Split(sug.OriginalCode, "\n") {
+		if strings.TrimSpace(line) != "" {
+			anchor = strings.TrimSpace(line)
+			break
+		}
+	}
+	if anchor == "" {
+		return "", false
+	}
+
+	found := false
+	for i := start; i <= end; i++ {
+		if strings.TrimSpace(lines[i]) == anchor {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return "", false
+	}
+
+	replaced := make([]string, 0, len(lines))
+	replaced = append(replaced, lines[:start]...)
+	replaced = append(replaced, strings.Split(sug.SuggestFix, "\n")...)
+	replaced = append(replaced, lines[end+1:]...)
+
+	return strings.Join(replaced, "\n"), true
+}
+
+// verifyApplied runs the verifier on the patched region of a just-written
+// fix. When the verifier flags a new problem, the user can keep the fix
+// anyway; otherwise (or with a nil reader) the file is reverted. Returns
+// whether the fix stays applied. Verification errors are non-fatal.
+func (s *ReviewSession) verifyApplied(sug review.Suggestion, oldContent, newContent, path string, perm os.FileMode, reader *bufio.Reader) bool {
+	Muted("  Verifying fix...")
+	note, err := s.verifier(sug, patchedRegion(oldContent, newContent, sug))
+	if err != nil {
+		Warning("  ⚠ Could not verify fix: " + err.Error())
+		return true
+	}
+	if note == "" {
+		return true
+	}
+
+	Warning("  ⚠ Fix verification flagged: " + note)
+	if reader != nil {
+		fmt.Print("  Keep this fix anyway? [y/N]: ")
+		confirm, _ := reader.ReadString('\n')
+		if strings.TrimSpace(strings.ToLower(confirm)) == "y" {
+			return true
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(oldContent), perm); err != nil {
+		Error("  ✗ Could not revert fix: " + err.Error())
+		return true
+	}
+	Muted("  ↩ Fix reverted")
+	return false
+}
+
+// patchedRegion extracts the applied fix plus a few lines of surrounding
+// context from the patched content, for verification
+func patchedRegion(oldContent, newContent string, sug review.Suggestion) string {
+	const contextLines = 5
+
+	start, _, ok := fixSpan(oldContent, sug)
+	if !ok {
+		start = sug.Line
+	}
+	if start < 1 {
+		return newContent
+	}
+
+	lines := strings.Split(newContent, "\n")
+	fixLines := strings.Count(sug.SuggestFix, "\n") + 1
+	from := start - 1 - contextLines
+	if from < 0 {
+		from = 0
+	}
+	to := start - 1 + fixLines + contextLines
+	if to > len(lines) {
+		to = len(lines)
+	}
+	if from >= to {
+		return newContent
+	}
+	return strings.Join(lines[from:to], "\n")
+}
+
+// editFix drops the suggested fix into a temporary file, opens it in
+// $EDITOR, and applies the edited version in place of the model's fix, so
+// fixes that are almost right can be adjusted instead of skipped. Returns
+// whether the edited fix was applied.
+func (s *ReviewSession) editFix(sug review.Suggestion, reader *bufio.Reader) bool {
+	if sug.SuggestFix == "" || sug.SuggestFix == "N/A" {
+		Muted("  No suggested fix to edit.")
+		return false
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		Warning("  ⚠ $EDITOR is not set")
+		return false
+	}
+
+	// Keep the reviewed file's extension so the editor picks a syntax mode
+	tmp, err := os.CreateTemp("", "prereview-fix-*"+filepath.Ext(sug.File))
+	if err != nil {
+		Error("  ✗ Could not create temp file: " + err.Error())
+		return false
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(sug.SuggestFix + "\n"); err != nil {
+		tmp.Close()
+		Error("  ✗ Could not write temp file: " + err.Error())
+		return false
+	}
+	tmp.Close()
+
+	// $EDITOR may carry arguments (e.g. "code --wait")
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], tmp.Name())...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		Error("  ✗ Editor exited with an error: " + err.Error())
+		return false
+	}
+
+	data, err := os.ReadFile(tmp.Name())
+	if err != nil {
+		Error("  ✗ Could not read edited fix: " + err.Error())
+		return false
+	}
+	edited := strings.TrimRight(string(data), "\n")
+	if strings.TrimSpace(edited) == "" {
+		Muted("  Empty fix - nothing applied.")
+		return false
+	}
+
+	sug.SuggestFix = edited
+	if !s.applyFix(sug, reader) {
+		Warning("  ⚠ Could not apply edited fix")
+		return false
+	}
+	return true
+}
+
+// copySuggestion puts the suggested fix on the system clipboard, for
+// pasting into an editor or a PR comment. With markdown set (the `cm`
+// action), or when there is no fix, the whole suggestion is copied as a
+// markdown block instead.
+func (s *ReviewSession) copySuggestion(sug review.Suggestion, markdown bool) {
+	text := sug.SuggestFix
+	what := "suggested fix"
+	if markdown || text == "" || text == "N/A" {
+		text = suggestionMarkdown(sug)
+		what = "suggestion as markdown"
+	}
+	if err := copyToClipboard(text); err != nil {
+		Muted("  " + err.Error())
+		return
+	}
+	Success("  ✓ Copied " + what + " to clipboard")
+}
+
+// suggestionMarkdown renders one suggestion as a self-contained markdown
+// block suitable for a PR comment
+func suggestionMarkdown(sug review.Suggestion) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**%s**\n\n", sug.Title)
+	fmt.Fprintf(&sb, "`%s:%d` - %s", sug.File, sug.Line, sug.Severity)
+	if sug.Category != "" {
+		fmt.Fprintf(&sb, " (%s)", sug.Category)
+	}
+	sb.WriteString("\n\n")
+	if sug.Description != "" {
+		sb.WriteString(sug.Description + "\n")
+	}
+	if sug.SuggestFix != "" && sug.SuggestFix != "N/A" {
+		sb.WriteString("\nSuggested fix:\n\n```\n" + strings.TrimRight(sug.SuggestFix, "\n") + "\n```\n")
+	}
+	return sb.String()
+}
+
+// previewFix shows the exact unified diff that applying the fix would make
+// to the file on disk, with surrounding context, without writing anything.
+// This runs the same code-location logic as applyFix, so what it shows is
+// what would actually change - not just the model's fix snippet.
+func (s *ReviewSession) previewFix(sug review.Suggestion) {
+	fmt.Println()
+
+	if sug.SuggestFix == "" || sug.SuggestFix == "N/A" ||
+		sug.OriginalCode == "" || sug.OriginalCode == "N/A" || sug.Unfixable {
+		Muted("  No applicable fix to preview.")
+		fmt.Println()
+		return
+	}
+
+	path, ok := safeFixPath(sug.File)
+	if !ok {
+		Muted("  Fix targets a path outside the repository - it would not apply.")
+		fmt.Println()
+		return
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		Muted("  Could not read " + sug.File + ": " + err.Error())
+		fmt.Println()
+		return
+	}
+
+	newContent, ok := replaceOriginalCode(string(content), sug)
+	if !ok || newContent == string(content) {
+		Muted("  Could not locate the original code - the fix would not apply.")
+		fmt.Println()
+		return
+	}
+
+	Page("  " + render(fileStyle, "Patch for "+sug.File) + "\n\n" + diffString(diffutil.Unified(string(content), newContent)))
+	fmt.Println()
+}
+
+// fileGroup summarizes one file's findings for the overview screen
+type fileGroup struct {
+	file      string
+	total     int
+	undecided int
+	counts    map[review.Severity]int
+}
+
+// fileGroups aggregates the suggestions per file, in the changeset's file
+// order, counting how many are still undecided
+func (s *ReviewSession) fileGroups() []fileGroup {
+	byFile := make(map[string]*fileGroup)
+	order := []string{}
+	for i, sug := range s.suggestions {
+		group, ok := byFile[sug.File]
+		if !ok {
+			group = &fileGroup{file: sug.File, counts: make(map[review.Severity]int)}
+			byFile[sug.File] = group
+			order = append(order, sug.File)
+		}
+		group.total++
+		group.counts[sug.Severity]++
+		if !s.decided[i] && !s.skippedMap[i] && !s.fixedMap[i] {
+			group.undecided++
+		}
+	}
+
+	groups := make([]fileGroup, 0, len(order))
+	for _, file := range order {
+		groups = append(groups, *byFile[file])
+	}
+	return groups
+}
+
+// overview runs the file-level entry screen: per-file finding counts by
+// severity, from which the user drills into one file at a time (or walks
+// everything). Returns a non-nil outcome when the user quits, nil once
+// every suggestion is decided.
+func (s *ReviewSession) overview(reader *bufio.Reader) *SessionOutcome {
+	for {
+		groups := s.fileGroups()
+		s.printOverview(groups)
+
+		undecided := 0
+		for _, group := range groups {
+			undecided += group.undecided
+		}
+		if undecided == 0 {
+			return nil
+		}
+
+		fmt.Print("\n  Review which file? [1-" + strconv.Itoa(len(groups)) + "] | " + Option("a") + "ll | " + Option("q") + "uit: ")
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			Error("Failed to read input")
+			return &SessionOutcome{Action: ActionAbort, Fixed: s.fixed, Skipped: s.skipped}
+		}
+		input = strings.TrimSpace(strings.ToLower(input))
+		fmt.Println()
+
+		switch {
+		case input == "q" || input == "quit":
+			return &SessionOutcome{Action: ActionAbort, Fixed: s.fixed, Skipped: s.skipped}
+
+		case input == "a" || input == "all":
+			s.current = 0
+			if outcome := s.walk(reader); outcome != nil {
+				return outcome
+			}
+
+		default:
+			idx, err := strconv.Atoi(input)
+			if err != nil || idx < 1 || idx > len(groups) {
+				Muted("  Enter a file number, a for all, or q to quit.")
+				continue
+			}
+			// Drill into one file by scoping the filter to it, then
+			// restore the previous scope for the next overview round.
+			// The exact-match field keeps the drill-down from dragging
+			// in other files the chosen path is a substring of.
+			previous := s.filter.exactFile
+			s.filter.exactFile = groups[idx-1].file
+			s.current = 0
+			outcome := s.walk(reader)
+			s.filter.exactFile = previous
+			if outcome != nil {
+				return outcome
+			}
+		}
+	}
+}
+
+// printOverview renders the per-file summary screen
+func (s *ReviewSession) printOverview(groups []fileGroup) {
+	Divider()
+	headerStyle := lipgloss.NewStyle().Bold(true)
+	fmt.Println(render(headerStyle, "📋 Findings by file"))
+	fmt.Println()
+
+	for i, group := range groups {
+		parts := []string{}
+		for _, sev := range []review.Severity{review.SeverityError, review.SeverityWarning, review.SeverityInfo, review.SeverityHint} {
+			if n := group.counts[sev]; n > 0 {
+				parts = append(parts, fmt.Sprintf("%d %s", n, sev))
+			}
+		}
+		line := fmt.Sprintf("  %2d. %-40s %d finding(s)  (%s)", i+1, group.file, group.total, strings.Join(parts, ", "))
+		if group.undecided == 0 {
+			line += "  ✓ done"
+		} else if group.undecided < group.total {
+			line += fmt.Sprintf("  [%d remaining]", group.undecided)
+		}
+		fmt.Println(line)
+	}
+	Divider()
+}
+
+// sessionFilter narrows which suggestions the session presents; empty
+// fields match everything
+type sessionFilter struct {
+	severity  string // Exact severity ("error", "warning", ...)
+	category  string // Exact category
+	file      string // Substring of the file path (user-typed filters)
+	exactFile string // Exact file path (overview drill-down)
+}
+
+// active reports whether any filter field is set
+func (f sessionFilter) active() bool {
+	return f.severity != "" || f.category != "" || f.file != "" || f.exactFile != ""
+}
+
+// matchesFilter reports whether a suggestion passes the active filter
+func (s *ReviewSession) matchesFilter(sug review.Suggestion) bool {
+	if s.filter.severity != "" && !strings.EqualFold(string(sug.Severity), s.filter.severity) {
+		return false
+	}
+	if s.filter.category != "" && !strings.EqualFold(sug.Category, s.filter.category) {
+		return false
+	}
+	if s.filter.file != "" && !strings.Contains(strings.ToLower(sug.File), strings.ToLower(s.filter.file)) {
+		return false
+	}
+	if s.filter.exactFile != "" && sug.File != s.filter.exactFile {
+		return false
+	}
+	return true
+}
+
+// statusBar describes the active filter and sort order, or "" when the
+// session is walking everything in model order
+func (s *ReviewSession) statusBar() string {
+	parts := []string{}
+	if s.filter.active() {
+		terms := []string{}
+		if s.filter.severity != "" {
+			terms = append(terms, "severity="+s.filter.severity)
+		}
+		if s.filter.category != "" {
+			terms = append(terms, "category="+s.filter.category)
+		}
+		if s.filter.file != "" {
+			terms = append(terms, "file="+s.filter.file)
+		}
+		if s.filter.exactFile != "" {
+			terms = append(terms, "file="+s.filter.exactFile)
+		}
+		parts = append(parts, "[filter: "+strings.Join(terms, " ")+"]")
+	}
+	if s.sortMode != "" {
+		parts = append(parts, "[sort: "+s.sortMode+"]")
+	}
+	return strings.Join(parts, " ")
+}
+
+// setFilter parses and applies a triage filter ("filter severity=error
+// file=cmd/"); bare "filter" or "filter clear" removes it. Setting or
+// clearing a filter rewinds to the first undecided match, so findings
+// earlier in the list aren't missed.
+func (s *ReviewSession) setFilter(args string) {
+	if args == "" || args == "clear" {
+		s.filter = sessionFilter{}
+		s.current = 0
+		Muted("  Filter cleared.")
+		return
+	}
+
+	filter := s.filter
+	for _, term := range strings.Fields(args) {
+		key, value, ok := strings.Cut(term, "=")
+		if !ok || value == "" {
+			Muted("  Usage: filter severity=<sev> category=<cat> file=<substring> (or 'filter clear')")
+			return
+		}
+		switch key {
+		case "severity":
+			filter.severity = value
+		case "category":
+			filter.category = value
+		case "file":
+			filter.file = value
+		default:
+			Muted("  Unknown filter key " + key + ". Use severity, category, or file.")
+			return
+		}
+	}
+	s.filter = filter
+	s.current = 0
+
+	matched := 0
+	for i, sug := range s.suggestions {
+		if !s.decided[i] && !s.skippedMap[i] && !s.fixedMap[i] && s.matchesFilter(sug) {
+			matched++
+		}
+	}
+	Muted(fmt.Sprintf("  Filter set - %d undecided suggestion(s) match.", matched))
+}
+
+// sortSuggestions reorders the remaining walk: "severity" puts errors
+// first, "file" follows the changeset's file order, "confidence" puts the
+// surest findings first. Decisions already made follow their suggestion to
+// its new index, and the cursor rewinds to the first undecided entry.
+func (s *ReviewSession) sortSuggestions(mode string) {
+	var less func(a, b review.Suggestion) bool
+	switch mode {
+	case "severity":
+		less = func(a, b review.Suggestion) bool { return severityRank(a.Severity) < severityRank(b.Severity) }
+	case "file":
+		less = func(a, b review.Suggestion) bool {
+			if a.File != b.File {
+				return a.File < b.File
+			}
+			return a.Line < b.Line
+		}
+	case "confidence":
+		less = func(a, b review.Suggestion) bool { return confidenceRank(a.Confidence) < confidenceRank(b.Confidence) }
+	default:
+		Muted("  Usage: sort severity | file | confidence")
+		return
+	}
+
+	order := make([]int, len(s.suggestions))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(s.suggestions[order[i]], s.suggestions[order[j]])
+	})
+
+	sorted := make([]review.Suggestion, len(order))
+	skippedMap := make(map[int]bool)
+	fixedMap := make(map[int]bool)
+	decided := make(map[int]bool)
+	for newIdx, oldIdx := range order {
+		sorted[newIdx] = s.suggestions[oldIdx]
+		if s.skippedMap[oldIdx] {
+			skippedMap[newIdx] = true
+		}
+		if s.fixedMap[oldIdx] {
+			fixedMap[newIdx] = true
+		}
+		// Everything already settled stays settled at its new position
+		if s.decided[oldIdx] || s.skippedMap[oldIdx] || s.fixedMap[oldIdx] {
+			decided[newIdx] = true
+		}
+	}
+
+	s.suggestions = sorted
+	s.result.Suggestions = sorted
+	s.skippedMap = skippedMap
+	s.fixedMap = fixedMap
+	s.decided = decided
+	s.sortMode = mode
+	s.current = 0
+	s.saveState()
+	Muted("  Sorted by " + mode + ".")
+}
+
+// severityRank orders severities most severe first, unknown last
+func severityRank(sev review.Severity) int {
+	switch sev {
+	case review.SeverityError:
+		return 0
+	case review.SeverityWarning:
+		return 1
+	case review.SeverityInfo:
+		return 2
+	case review.SeverityHint:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// confidenceRank orders confidences surest first, unknown last
+func confidenceRank(conf review.Confidence) int {
+	switch conf {
+	case review.ConfidenceHigh:
+		return 0
+	case review.ConfidenceMedium:
+		return 1
+	case review.ConfidenceLow:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// parseJump recognizes the jump-to-suggestion command: "g 12", "goto 12",
+// or a bare number. The returned index is 1-based.
+func (s *ReviewSession) parseJump(input string) (int, bool) {
+	fields := strings.Fields(input)
+	switch {
+	case len(fields) == 2 && (fields[0] == s.key("jump") || fields[0] == "goto"):
+		input = fields[1]
+	case len(fields) == 1:
+		input = fields[0]
+	default:
+		return 0, false
+	}
+	idx, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
+// undoDecision clears any earlier decision on a suggestion so navigating
+// back to it presents it fresh. An already-applied fix stays in the file -
+// only the tally is reverted.
+func (s *ReviewSession) undoDecision(idx int) {
+	if s.skippedMap[idx] {
+		s.skipped--
+		delete(s.skippedMap, idx)
+	}
+	if s.fixedMap[idx] {
+		s.fixed--
+		delete(s.fixedMap, idx)
+		Muted("  Note: the fix applied earlier remains in the file.")
+	}
+	delete(s.decided, idx)
+}
+
+// parseBulkAction recognizes the uppercase bulk commands: "A" / "S" settle
+// every remaining suggestion, and an optional trailing word scopes the
+// action to one category ("A security", "S style")
+func parseBulkAction(input string) (action, category string, ok bool) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 || len(fields) > 2 {
+		return "", "", false
+	}
+	if fields[0] != "A" && fields[0] != "S" {
+		return "", "", false
+	}
+	if len(fields) == 2 {
+		category = fields[1]
+	}
+	return fields[0], category, true
+}
+
+// bulkApply applies every remaining high-confidence fix (optionally only in
+// one category). Low-confidence findings and fixes that fail to apply are
+// left for individual review rather than silently skipped.
+func (s *ReviewSession) bulkApply(category string) {
+	applied, failed, lowConf := 0, 0, 0
+	for i := s.current; i < len(s.suggestions); i++ {
+		if s.decided[i] {
+			continue
+		}
+		sug := s.suggestions[i]
+		if category != "" && !strings.EqualFold(sug.Category, category) {
+			continue
+		}
+		if sug.Confidence == review.ConfidenceLow {
+			lowConf++
+			continue
+		}
+		if s.applyFix(sug, nil) {
+			s.fixed++
+			s.fixedMap[i] = true
+			s.decided[i] = true
+			applied++
+		} else {
+			failed++
+		}
+	}
+
+	if applied > 0 {
+		Success(fmt.Sprintf("  ✓ Applied %d fix(es)", applied))
+	} else {
+		Muted("  No remaining fixes matched.")
+	}
+	if failed > 0 {
+		Warning(fmt.Sprintf("  ⚠ %d fix(es) could not be applied automatically - review them individually", failed))
+	}
+	if lowConf > 0 {
+		Muted(fmt.Sprintf("  %d low-confidence finding(s) left for individual review", lowConf))
+	}
+}
+
+// bulkSkip skips every remaining suggestion, optionally only in one category
+func (s *ReviewSession) bulkSkip(category string) {
+	count := 0
+	for i := s.current; i < len(s.suggestions); i++ {
+		if s.decided[i] {
+			continue
+		}
+		if category != "" && !strings.EqualFold(s.suggestions[i].Category, category) {
+			continue
+		}
+		s.skipped++
+		s.skippedMap[i] = true
+		s.decided[i] = true
+		count++
+	}
+
+	if count > 0 {
+		Muted(fmt.Sprintf("  ⏭ Skipped %d suggestion(s)", count))
+	} else {
+		Muted("  No remaining suggestions matched.")
+	}
+}
+
+// openInEditor launches the configured editor at the flagged file:line so
+// the user can look at the surrounding code before deciding. The
+// editor_command template takes precedence; without one, $EDITOR is opened
+// on the file (most editors accept +N for the line, but that can't be
+// assumed, so the bare template only passes the file).
+func (s *ReviewSession) openInEditor(sug review.Suggestion) {
+	template := s.editorCmd
+	if template == "" {
+		if editor := os.Getenv("EDITOR"); editor != "" {
+			template = editor + " {file}"
+		}
+	}
+	if template == "" {
+		Muted("  Set editor_command (e.g. \"code -g {file}:{line}\") or $EDITOR to use open.")
+		return
+	}
+
+	line := sug.Line
+	if line < 1 {
+		line = 1
+	}
+	command := strings.ReplaceAll(template, "{file}", sug.File)
+	command = strings.ReplaceAll(command, "{line}", strconv.Itoa(line))
+
+	parts := strings.Fields(command)
+	cmd := exec.Command(parts[0], parts[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		Error("  ✗ Could not open editor: " + err.Error())
+	}
+}
+
+// explain reads a follow-up question from the user, sends it to the model
+// together with the suggestion, and renders the answer inline
+func (s *ReviewSession) explain(sug review.Suggestion, reader *bufio.Reader) {
+	fmt.Print("  Question (empty to cancel): ")
+	question, err := reader.ReadString('\n')
+	if err != nil {
+		Error("Failed to read input")
+		return
+	}
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return
+	}
+
+	fmt.Println()
+	Muted("  Asking the model...")
+
+	answer, err := s.explainer(sug, question)
+	if err != nil {
+		Error("  ✗ Could not get an answer: " + err.Error())
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(render(textStyle, "  "+strings.ReplaceAll(strings.TrimSpace(answer), "\n", "\n  ")))
+	fmt.Println()
+}
+
+// modifiedFiles lists the files applied fixes have touched, so a
+// re-review can target just those instead of the whole changeset.
+// Snapshots are keyed by resolved absolute path, but the re-review filter
+// matches the repo-relative paths git reports, so convert back here.
+func (s *ReviewSession) modifiedFiles() []string {
+	repoRoot, rootErr := git.GetRepoRoot()
+	files := make([]string, 0, len(s.snapshots))
+	for path := range s.snapshots {
+		if rootErr == nil {
+			if rel, err := filepath.Rel(repoRoot, path); err == nil {
+				path = filepath.ToSlash(rel)
+			}
+		}
+		files = append(files, path)
+	}
+	return files
+}
+
+// rollback restores every file the session modified to its pre-session
+// state and re-stages it, undoing all applied fixes at once
+func (s *ReviewSession) rollback() {
+	restored := 0
+	for path, snapshot := range s.snapshots {
+		if err := os.WriteFile(path, []byte(snapshot.content), snapshot.perm); err != nil {
+			Error("  ✗ Could not restore " + path + ": " + err.Error())
+			continue
+		}
+		if err := exec.Command("git", "add", path).Run(); err != nil {
+			Warning("  File restored but could not stage: " + path)
+		}
+		restored++
+	}
+	if restored > 0 {
+		Success(fmt.Sprintf("  ✓ Rolled back %d file(s)", restored))
+	}
+}
+
 // viewDiff shows the diff for a file
 func (s *ReviewSession) viewDiff(sug review.Suggestion) {
 	fmt.Println()
@@ -289,24 +1654,13 @@ func (s *ReviewSession) viewDiff(sug review.Suggestion) {
 		return
 	}
 
-	// Print header
-	diffHeaderStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#60A5FA"))
-	fmt.Println("  " + diffHeaderStyle.Render("Diff for "+sug.File))
-	fmt.Println()
-
-	// Print the diff with some indentation
-	lines := strings.Split(string(output), "\n")
-	const maxLines = 50 // Limit output to avoid overwhelming the terminal
-	
-	for i, line := range lines {
-		if i >= maxLines {
-			Muted(fmt.Sprintf("  ... (%d more lines, use 'git diff --cached %s' to see full diff)", len(lines)-maxLines, sug.File))
-			break
-		}
-		fmt.Println("  " + line)
+	// Long diffs go through the pager instead of being truncated
+	var sb strings.Builder
+	sb.WriteString("  " + render(fileStyle, "Diff for "+sug.File) + "\n\n")
+	for _, line := range strings.Split(strings.TrimRight(string(output), "\n"), "\n") {
+		sb.WriteString("  " + line + "\n")
 	}
+	Page(sb.String())
 	fmt.Println()
 }
 
@@ -320,10 +1674,29 @@ func (s *ReviewSession) printSummary() {
 	total := len(s.suggestions)
 	remaining := total - s.fixed - s.skipped
 
-	fmt.Printf("  %s %d fixed\n", successStyle.Render("✓"), s.fixed)
-	fmt.Printf("  %s %d skipped\n", warningStyle.Render("⏭"), s.skipped)
+	fmt.Printf("  %s %d fixed\n", render(successStyle, "✓"), s.fixed)
+	fmt.Printf("  %s %d skipped\n", render(warningStyle, "⏭"), s.skipped)
 	if remaining > 0 {
-		fmt.Printf("  %s %d remaining\n", errorStyle.Render("•"), remaining)
+		fmt.Printf("  %s %d remaining\n", render(errorStyle, "•"), remaining)
+	}
+
+	// Breakdown by severity, category, and contributing models
+	stats := s.result.Stats()
+	if line := stats.SeverityLine(); line != "" {
+		Muted("  By severity: " + line)
+	}
+	if line := stats.CategoryLine(); line != "" {
+		Muted("  By category: " + line)
+	}
+	if len(stats.Models) > 0 {
+		Muted("  Models: " + strings.Join(stats.Models, ", "))
+	}
+
+	// Overall AI assessment of the changeset
+	if s.result.Summary != "" {
+		fmt.Println()
+		fmt.Println(summaryStyle.Render("Overall assessment"))
+		Muted("  " + strings.ReplaceAll(s.result.Summary, "\n", "\n  "))
 	}
 
 	Divider()
@@ -0,0 +1,157 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/emilushi/prereview/internal/review"
+)
+
+// sessionStateFile is where interrupted-session progress is persisted,
+// under the prereview data directory
+const sessionStateFile = "session.json"
+
+// sessionState is the serialized progress of an interactive session, so
+// `prereview resume` can pick up where an interrupted session left off
+type sessionState struct {
+	Current        int   `json:"current"`
+	Fixed          int   `json:"fixed"`
+	Skipped        int   `json:"skipped"`
+	SkippedIndices []int `json:"skipped_indices"`
+	FixedIndices   []int `json:"fixed_indices,omitempty"`   // So back-navigation after resume can undo tallies
+	DecidedIndices []int `json:"decided_indices,omitempty"` // Settled ahead of current by bulk actions
+	Total          int   `json:"total"`                     // Sanity check against the saved review
+}
+
+// SetStateDir enables session persistence: progress is written to dir after
+// every decision and removed once the session finishes cleanly
+func (s *ReviewSession) SetStateDir(dir string) {
+	s.stateDir = dir
+}
+
+// sessionExport bundles the review result with the session's progress, so
+// an exported session is self-contained and can be resumed on another
+// checkout (or after the prereview data directory is cleaned)
+type sessionExport struct {
+	Result *review.ReviewResult `json:"result"`
+	State  sessionState         `json:"state"`
+}
+
+// SetExportPath mirrors the session (review result plus progress) to a
+// standalone file after every decision (--save-session). Unlike the state
+// directory, the export is kept when the session completes.
+func (s *ReviewSession) SetExportPath(path string) {
+	s.exportPath = path
+}
+
+// LoadSessionExport reads a session export file and reconstructs the
+// session at its saved position
+func LoadSessionExport(path string, verbose bool) (*ReviewSession, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session file: %w", err)
+	}
+
+	var export sessionExport
+	if err := json.Unmarshal(data, &export); err != nil || export.Result == nil {
+		return nil, fmt.Errorf("%s is not a saved prereview session", path)
+	}
+	if export.State.Total != len(export.Result.Suggestions) {
+		return nil, fmt.Errorf("session file %s is inconsistent (progress does not match suggestions)", path)
+	}
+
+	session := NewReviewSession(export.Result, verbose)
+	session.current = export.State.Current
+	session.fixed = export.State.Fixed
+	session.skipped = export.State.Skipped
+	for _, idx := range export.State.SkippedIndices {
+		session.skippedMap[idx] = true
+	}
+	for _, idx := range export.State.FixedIndices {
+		session.fixedMap[idx] = true
+	}
+	for _, idx := range export.State.DecidedIndices {
+		session.decided[idx] = true
+	}
+	return session, nil
+}
+
+// Restore loads persisted session progress, returning true when an
+// interrupted session was found and its position restored. Progress that
+// doesn't match the saved review (different suggestion count) is discarded.
+func (s *ReviewSession) Restore() bool {
+	if s.stateDir == "" {
+		return false
+	}
+
+	data, err := os.ReadFile(filepath.Join(s.stateDir, sessionStateFile))
+	if err != nil {
+		return false
+	}
+
+	var state sessionState
+	if err := json.Unmarshal(data, &state); err != nil || state.Total != len(s.suggestions) {
+		s.clearState()
+		return false
+	}
+
+	s.current = state.Current
+	s.fixed = state.Fixed
+	s.skipped = state.Skipped
+	for _, idx := range state.SkippedIndices {
+		s.skippedMap[idx] = true
+	}
+	for _, idx := range state.FixedIndices {
+		s.fixedMap[idx] = true
+	}
+	for _, idx := range state.DecidedIndices {
+		s.decided[idx] = true
+	}
+	return state.Current > 0 || state.Fixed > 0 || state.Skipped > 0
+}
+
+// saveState persists the session's progress after a decision
+func (s *ReviewSession) saveState() {
+	if s.stateDir == "" && s.exportPath == "" {
+		return
+	}
+
+	state := sessionState{
+		Current: s.current,
+		Fixed:   s.fixed,
+		Skipped: s.skipped,
+		Total:   len(s.suggestions),
+	}
+	for idx := range s.skippedMap {
+		state.SkippedIndices = append(state.SkippedIndices, idx)
+	}
+	for idx := range s.fixedMap {
+		state.FixedIndices = append(state.FixedIndices, idx)
+	}
+	for idx := range s.decided {
+		state.DecidedIndices = append(state.DecidedIndices, idx)
+	}
+
+	if s.stateDir != "" {
+		if data, err := json.Marshal(state); err == nil {
+			_ = os.WriteFile(filepath.Join(s.stateDir, sessionStateFile), data, 0644)
+		}
+	}
+
+	if s.exportPath != "" {
+		export := sessionExport{Result: s.result, State: state}
+		if data, err := json.MarshalIndent(export, "", "  "); err == nil {
+			_ = os.WriteFile(s.exportPath, data, 0644)
+		}
+	}
+}
+
+// clearState removes persisted progress once the session completes
+func (s *ReviewSession) clearState() {
+	if s.stateDir == "" {
+		return
+	}
+	_ = os.Remove(filepath.Join(s.stateDir, sessionStateFile))
+}
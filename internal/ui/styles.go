@@ -3,127 +3,359 @@ package ui
 import (
 	"fmt"
 	"os"
+	"runtime"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
 var (
-	// Colors
-	primaryColor   = lipgloss.Color("#7C3AED")
-	successColor   = lipgloss.Color("#10B981")
-	warningColor   = lipgloss.Color("#F59E0B")
-	errorColor     = lipgloss.Color("#EF4444")
-	infoColor      = lipgloss.Color("#3B82F6")
-	mutedColor     = lipgloss.Color("#6B7280")
-
-	// Styles
+	// Palette, selected by applyTheme
+	primaryColor   lipgloss.Color
+	successColor   lipgloss.Color
+	warningColor   lipgloss.Color
+	errorColor     lipgloss.Color
+	infoColor      lipgloss.Color
+	mutedColor     lipgloss.Color
+	accentColor    lipgloss.Color // File paths, section headers
+	secondaryColor lipgloss.Color // Locations, surrounding context
+	textColor      lipgloss.Color // Descriptions, model answers
+	surfaceColor   lipgloss.Color // Header bars, code blocks
+	surfaceText    lipgloss.Color
+	borderColor    lipgloss.Color
+	lineColor      lipgloss.Color
+	flagColor      lipgloss.Color // Flagged lines in source context
+
+	// Styles, rebuilt from the palette on theme change
+	titleStyle            lipgloss.Style
+	successStyle          lipgloss.Style
+	warningStyle          lipgloss.Style
+	errorStyle            lipgloss.Style
+	infoStyle             lipgloss.Style
+	mutedStyle            lipgloss.Style
+	secondaryStyle        lipgloss.Style
+	textStyle             lipgloss.Style
+	fileStyle             lipgloss.Style
+	lineStyle             lipgloss.Style
+	boxStyle              lipgloss.Style
+	suggestionHeaderStyle lipgloss.Style
+	sessionHeaderStyle    lipgloss.Style
+	badgeStyle            lipgloss.Style
+	codeStyle             lipgloss.Style
+	sessionCodeStyle      lipgloss.Style
+	optionStyle           lipgloss.Style
+	diffAddStyle          lipgloss.Style
+	diffDelStyle          lipgloss.Style
+	diffHunkStyle         lipgloss.Style
+	contextFlagStyle      lipgloss.Style
+
+	// plainMode strips all styling; it defaults to on when stdout is not
+	// a terminal, so hook runs and CI logs stay free of escape codes
+	plainMode = !isTerminal(os.Stdout)
+
+	// quietMode suppresses informational chatter (--quiet), leaving only
+	// summary lines, warnings, and errors
+	quietMode = false
+
+	// asciiMode replaces Unicode glyphs and emoji with ASCII stand-ins,
+	// for consoles that can't render them (ui.ascii setting)
+	asciiMode = false
+
+	// accessibleMode makes output screen-reader friendly: no color-only
+	// signaling, no box-drawing, no in-place updates, and every message
+	// prefixed with its semantic level in text (ui.accessible setting)
+	accessibleMode = false
+)
+
+func init() {
+	// NO_COLOR (https://no-color.org) and CLICOLOR=0 disable styling
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("CLICOLOR") == "0" {
+		plainMode = true
+	}
+	// Legacy Windows consoles that can't switch to UTF-8 get ASCII output
+	// instead of mojibake
+	if runtime.GOOS == "windows" && !enableConsoleUTF8() {
+		asciiMode = true
+	}
+	applyTheme(true)
+}
+
+// SetQuiet toggles quiet mode
+func SetQuiet(quiet bool) { quietMode = quiet }
+
+// SetPlain forces styling off (or back on), overriding terminal detection
+func SetPlain(plain bool) { plainMode = plain }
+
+// SetASCII toggles the ASCII-only fallback (the ui.ascii setting)
+func SetASCII(ascii bool) { asciiMode = ascii }
+
+// SetAccessible toggles screen-reader friendly output. Colors and glyphs
+// carry no information a screen reader can voice, so accessible mode
+// implies plain, ASCII-only output on top of the level prefixes.
+func SetAccessible(accessible bool) {
+	accessibleMode = accessible
+	if accessible {
+		plainMode = true
+		asciiMode = true
+	}
+}
+
+// IsAccessible reports whether screen-reader friendly output is on, so
+// callers can avoid in-place updates like spinners and progress bars
+func IsAccessible() bool { return accessibleMode }
+
+// levelled prefixes text with its semantic level in accessible mode, so a
+// screen reader voices what kind of message follows instead of relying on
+// color. Indentation is dropped - it only conveys structure visually.
+func levelled(level, text string) string {
+	if !accessibleMode {
+		return text
+	}
+	return level + ": " + strings.TrimSpace(asciiFold(text))
+}
+
+// SetTheme selects the color palette: "dark", "light", or "auto", which
+// detects the terminal background
+func SetTheme(name string) {
+	switch strings.ToLower(name) {
+	case "light":
+		applyTheme(false)
+	case "dark":
+		applyTheme(true)
+	default: // "auto" or unset
+		applyTheme(lipgloss.HasDarkBackground())
+	}
+}
+
+// applyTheme sets the palette for a dark or light terminal background and
+// rebuilds every style from it. The light palette trades the pastel
+// dark-background shades for darker ones that stay readable on white.
+func applyTheme(dark bool) {
+	if dark {
+		primaryColor = "#7C3AED"
+		successColor = "#10B981"
+		warningColor = "#F59E0B"
+		errorColor = "#EF4444"
+		infoColor = "#3B82F6"
+		mutedColor = "#6B7280"
+		accentColor = "#60A5FA"
+		secondaryColor = "#9CA3AF"
+		textColor = "#D1D5DB"
+		surfaceColor = "#1F2937"
+		surfaceText = "#F9FAFB"
+		borderColor = "#4B5563"
+		lineColor = "#A78BFA"
+		flagColor = "#FBBF24"
+	} else {
+		primaryColor = "#6D28D9"
+		successColor = "#047857"
+		warningColor = "#B45309"
+		errorColor = "#B91C1C"
+		infoColor = "#1D4ED8"
+		mutedColor = "#6B7280"
+		accentColor = "#1D4ED8"
+		secondaryColor = "#4B5563"
+		textColor = "#1F2937"
+		surfaceColor = "#E5E7EB"
+		surfaceText = "#111827"
+		borderColor = "#9CA3AF"
+		lineColor = "#6D28D9"
+		flagColor = "#92400E"
+	}
+
 	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(primaryColor).
-			MarginBottom(1)
+		Bold(true).
+		Foreground(primaryColor).
+		MarginBottom(1)
 
 	successStyle = lipgloss.NewStyle().
-			Foreground(successColor)
+		Foreground(successColor)
 
 	warningStyle = lipgloss.NewStyle().
-			Foreground(warningColor)
+		Foreground(warningColor)
 
 	errorStyle = lipgloss.NewStyle().
-			Foreground(errorColor)
+		Foreground(errorColor)
 
 	infoStyle = lipgloss.NewStyle().
-			Foreground(infoColor)
+		Foreground(infoColor)
 
 	mutedStyle = lipgloss.NewStyle().
-			Foreground(mutedColor)
+		Foreground(mutedColor)
+
+	secondaryStyle = lipgloss.NewStyle().
+		Foreground(secondaryColor)
+
+	textStyle = lipgloss.NewStyle().
+		Foreground(textColor)
 
 	fileStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#60A5FA"))
+		Bold(true).
+		Foreground(accentColor)
 
 	lineStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#A78BFA"))
+		Foreground(lineColor)
 
 	boxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#4B5563")).
-			Padding(0, 1)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(0, 1)
 
 	suggestionHeaderStyle = lipgloss.NewStyle().
-				Bold(true).
-				Background(lipgloss.Color("#1F2937")).
-				Foreground(lipgloss.Color("#F9FAFB")).
-				Padding(0, 1).
-				Width(60)
+		Bold(true).
+		Background(surfaceColor).
+		Foreground(surfaceText).
+		Padding(0, 1).
+		Width(60)
+
+	sessionHeaderStyle = lipgloss.NewStyle().
+		Bold(true).
+		Background(surfaceColor).
+		Foreground(surfaceText).
+		Padding(0, 1)
+
+	badgeStyle = lipgloss.NewStyle().
+		Foreground(secondaryColor).
+		Background(surfaceColor).
+		Padding(0, 1)
 
 	codeStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color("#1F2937")).
-			Foreground(lipgloss.Color("#D1D5DB")).
-			Padding(0, 1)
+		Background(surfaceColor).
+		Foreground(textColor).
+		Padding(0, 1)
+
+	sessionCodeStyle = lipgloss.NewStyle().
+		Background(surfaceColor).
+		Foreground(textColor).
+		Padding(0, 1).
+		MarginLeft(2)
 
 	optionStyle = lipgloss.NewStyle().
-			Foreground(primaryColor).
-			Bold(true)
+		Foreground(primaryColor).
+		Bold(true)
+
+	diffAddStyle = lipgloss.NewStyle().
+		Foreground(successColor)
+
+	diffDelStyle = lipgloss.NewStyle().
+		Foreground(errorColor)
+
+	diffHunkStyle = lipgloss.NewStyle().
+		Foreground(mutedColor)
+
+	contextFlagStyle = lipgloss.NewStyle().
+		Foreground(flagColor)
+}
+
+// asciiReplacer maps the Unicode glyphs the UI uses onto ASCII stand-ins
+var asciiReplacer = strings.NewReplacer(
+	"✓", "+", "✗", "x", "⚠", "!", "ℹ", "i", "•", "*",
+	"⏭", ">>", "⏩", ">>", "›", ">", "│", "|", "━", "-",
+	"█", "#", "░", ".", "●", "*", "↩", "<",
 )
 
+// asciiFold rewrites text for ASCII-only consoles: known glyphs become
+// stand-ins and everything else non-ASCII (emoji) is dropped
+func asciiFold(text string) string {
+	if !asciiMode {
+		return text
+	}
+	text = asciiReplacer.Replace(text)
+	var b strings.Builder
+	for _, r := range text {
+		if r < 128 {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// render applies the ASCII fallback and a style (unless plain output is in
+// effect); all user-visible styled text funnels through here
+func render(style lipgloss.Style, text string) string {
+	text = asciiFold(text)
+	if plainMode {
+		return text
+	}
+	return style.Render(text)
+}
+
 // Title prints a styled title
 func Title(text string) {
-	fmt.Println(titleStyle.Render(text))
+	if quietMode {
+		return
+	}
+	fmt.Println(render(titleStyle, text))
 }
 
 // Success prints a success message
 func Success(text string) {
-	fmt.Println(successStyle.Render(text))
+	fmt.Println(render(successStyle, levelled("OK", text)))
 }
 
 // Warning prints a warning message
 func Warning(text string) {
-	fmt.Println(warningStyle.Render(text))
+	fmt.Println(render(warningStyle, levelled("WARNING", text)))
 }
 
 // Error prints an error message
 func Error(text string) {
-	fmt.Fprintln(os.Stderr, errorStyle.Render(text))
+	fmt.Fprintln(os.Stderr, render(errorStyle, levelled("ERROR", text)))
 }
 
-// Info prints an info message
+// Info prints an info message; suppressed in quiet mode
 func Info(text string) {
-	fmt.Println(infoStyle.Render(text))
+	if quietMode {
+		return
+	}
+	fmt.Println(render(infoStyle, levelled("INFO", text)))
 }
 
-// Muted prints muted text
+// Summary prints a result summary line; unlike Info it survives quiet
+// mode, so --quiet runs still report what was found
+func Summary(text string) {
+	fmt.Println(render(infoStyle, levelled("SUMMARY", text)))
+}
+
+// Muted prints muted text; suppressed in quiet mode
 func Muted(text string) {
-	fmt.Println(mutedStyle.Render(text))
+	if quietMode {
+		return
+	}
+	fmt.Println(render(mutedStyle, levelled("NOTE", text)))
 }
 
 // File formats a file path
 func File(path string) string {
-	return fileStyle.Render(path)
+	return render(fileStyle, path)
 }
 
 // Line formats a line number
 func Line(num int) string {
-	return lineStyle.Render(fmt.Sprintf("L%d", num))
+	return render(lineStyle, fmt.Sprintf("L%d", num))
 }
 
 // Box wraps content in a box
 func Box(content string) string {
-	return boxStyle.Render(content)
+	return render(boxStyle, content)
 }
 
 // Code formats code
 func Code(content string) string {
-	return codeStyle.Render(content)
+	return render(codeStyle, content)
 }
 
 // Option formats an option key
 func Option(key string) string {
-	return optionStyle.Render("[" + key + "]")
+	return render(optionStyle, "["+key+"]")
 }
 
-// SeverityStyle returns the style for a severity level
+// SeverityStyle returns the style for a severity level (unstyled when
+// plain output is in effect)
 func SeverityStyle(severity string) lipgloss.Style {
+	if plainMode {
+		return lipgloss.NewStyle()
+	}
 	switch severity {
 	case "error":
 		return errorStyle
@@ -140,37 +372,41 @@ func SeverityStyle(severity string) lipgloss.Style {
 func SeverityIcon(severity string) string {
 	switch severity {
 	case "error":
-		return "✗"
+		return asciiFold("✗")
 	case "warning":
-		return "⚠"
+		return asciiFold("⚠")
 	case "info":
-		return "ℹ"
+		return asciiFold("ℹ")
 	default:
-		return "•"
+		return asciiFold("•")
 	}
 }
 
-// Divider prints a horizontal divider
+// Divider prints a horizontal divider; suppressed in quiet mode (and in
+// accessible mode, where a screen reader would voice every dash)
 func Divider() {
-	fmt.Println(mutedStyle.Render(strings.Repeat("━", 60)))
+	if quietMode || accessibleMode {
+		return
+	}
+	fmt.Println(render(mutedStyle, strings.Repeat("━", 60)))
 }
 
 // SuccessIcon returns a green checkmark
 func SuccessIcon() string {
-	return successStyle.Render("✓")
+	return render(successStyle, "✓")
 }
 
 // ErrorIcon returns a red X
 func ErrorIcon() string {
-	return errorStyle.Render("✗")
+	return render(errorStyle, "✗")
 }
 
 // WarningIcon returns a yellow warning symbol
 func WarningIcon() string {
-	return warningStyle.Render("⚠")
+	return render(warningStyle, "⚠")
 }
 
 // InfoIcon returns a blue info symbol
 func InfoIcon() string {
-	return infoStyle.Render("ℹ")
+	return render(infoStyle, "ℹ")
 }
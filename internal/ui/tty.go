@@ -0,0 +1,19 @@
+package ui
+
+import (
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// IsInteractive reports whether both stdin and stdout are attached to a
+// terminal, i.e. an interactive session can actually prompt the user.
+// Piped output, CI runners, and IDE tasks all return false.
+func IsInteractive() bool {
+	return isTerminal(os.Stdin) && isTerminal(os.Stdout)
+}
+
+func isTerminal(f *os.File) bool {
+	fd := f.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
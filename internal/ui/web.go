@@ -0,0 +1,234 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// webPage renders the whole session as one page: every finding with its
+// fix and apply/skip buttons, plus the final proceed/abort choice. Plain
+// form posts keep it dependency-free and working in any browser a GUI
+// git client might open.
+const webPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>PreReview session</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", sans-serif; max-width: 54rem; margin: 2rem auto; padding: 0 1rem; color: #1f2937; }
+  h1 { font-size: 1.3rem; }
+  .summary { color: #6b7280; margin-bottom: 1.5rem; }
+  .finding { border: 1px solid #d1d5db; border-radius: 6px; padding: 0.8rem 1rem; margin-bottom: 1rem; }
+  .finding.decided { opacity: 0.55; }
+  .loc { color: #6b7280; font-size: 0.85rem; }
+  .sev { font-weight: 600; text-transform: uppercase; font-size: 0.75rem; padding: 0.1rem 0.4rem; border-radius: 4px; color: #fff; }
+  .sev.error { background: #b91c1c; } .sev.warning { background: #b45309; } .sev.info { background: #1d4ed8; } .sev.hint { background: #6b7280; }
+  pre { background: #f3f4f6; padding: 0.6rem; border-radius: 4px; overflow-x: auto; font-size: 0.85rem; }
+  button { padding: 0.3rem 0.9rem; border-radius: 4px; border: 1px solid #9ca3af; background: #fff; cursor: pointer; }
+  button.apply { background: #047857; border-color: #047857; color: #fff; }
+  button.commit { background: #1d4ed8; border-color: #1d4ed8; color: #fff; }
+  form { display: inline; }
+  .state { font-weight: 600; } .state.fixed { color: #047857; } .state.skipped { color: #6b7280; }
+  .error-note { color: #b91c1c; }
+</style>
+</head>
+<body>
+<h1>PreReview session</h1>
+<p class="summary">{{.Total}} finding(s) &middot; {{.Fixed}} fixed &middot; {{.Skipped}} skipped &middot; {{.Undecided}} undecided</p>
+{{if .LastError}}<p class="error-note">{{.LastError}}</p>{{end}}
+{{range .Findings}}
+<div class="finding{{if or .Fixed .Skipped}} decided{{end}}">
+  <p><span class="sev {{.Severity}}">{{.Severity}}</span> <strong>{{.Title}}</strong></p>
+  <p class="loc">{{.File}}{{if .Line}}:{{.Line}}{{end}}{{if .Category}} &middot; {{.Category}}{{end}}{{if .Confidence}} &middot; {{.Confidence}} confidence{{end}}</p>
+  {{if .Description}}<p>{{.Description}}</p>{{end}}
+  {{if .Fix}}<pre>{{.Fix}}</pre>{{end}}
+  {{if .Fixed}}<p class="state fixed">Fix applied</p>
+  {{else if .Skipped}}<p class="state skipped">Skipped</p>
+  {{else}}
+  {{if .Fixable}}<form method="post" action="/apply"><input type="hidden" name="i" value="{{.Index}}"><button class="apply">Apply fix</button></form>{{end}}
+  <form method="post" action="/skip"><input type="hidden" name="i" value="{{.Index}}"><button>Skip</button></form>
+  {{end}}
+</div>
+{{end}}
+<p>
+  <form method="post" action="/finish"><input type="hidden" name="action" value="commit"><button class="commit">Proceed with commit</button></form>
+  <form method="post" action="/finish"><input type="hidden" name="action" value="abort"><button>Abort</button></form>
+</p>
+</body>
+</html>`
+
+var webTemplate = template.Must(template.New("session").Parse(webPage))
+
+// webFinding is one suggestion prepared for the template
+type webFinding struct {
+	Index       int
+	File        string
+	Line        int
+	Severity    string
+	Confidence  string
+	Category    string
+	Title       string
+	Description string
+	Fix         string
+	Fixable     bool
+	Fixed       bool
+	Skipped     bool
+}
+
+// webData is the template payload for one page render
+type webData struct {
+	Total     int
+	Fixed     int
+	Skipped   int
+	Undecided int
+	LastError string
+	Findings  []webFinding
+}
+
+// RunWeb serves the session over a local HTTP server instead of the TTY,
+// for GUI git clients whose hooks have no terminal to be interactive in.
+// It blocks until the user chooses how to proceed in the browser.
+func (s *ReviewSession) RunWeb(addr string) SessionOutcome {
+	if addr == "" {
+		addr = "127.0.0.1:0"
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		Error(fmt.Sprintf("Could not start web session on %s: %v", addr, err))
+		return SessionOutcome{Action: ActionAbort, Fixed: s.fixed, Skipped: s.skipped}
+	}
+
+	var mu sync.Mutex
+	lastError := ""
+	done := make(chan Action, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		data := s.webData(lastError)
+		lastError = ""
+		mu.Unlock()
+		_ = webTemplate.Execute(w, data)
+	})
+	mux.HandleFunc("/apply", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if idx, ok := s.webIndex(r); ok {
+			if s.applyFix(s.suggestions[idx], nil) {
+				s.fixed++
+				s.fixedMap[idx] = true
+			} else {
+				lastError = "Could not apply the fix for " + s.suggestions[idx].Title + " - skip it or fix manually."
+			}
+			s.saveState()
+		}
+		mu.Unlock()
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+	})
+	mux.HandleFunc("/skip", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		if idx, ok := s.webIndex(r); ok {
+			s.skipped++
+			s.skippedMap[idx] = true
+			s.saveState()
+		}
+		mu.Unlock()
+		http.Redirect(w, r, "/", http.StatusSeeOther)
+	})
+	mux.HandleFunc("/finish", func(w http.ResponseWriter, r *http.Request) {
+		action := ActionAbort
+		if r.FormValue("action") == "commit" {
+			action = ActionCommit
+		}
+		fmt.Fprintln(w, "Session finished - you can close this tab.")
+		select {
+		case done <- action:
+		default: // A second click after the first already finished
+		}
+	})
+
+	server := &http.Server{Handler: mux}
+	go func() { _ = server.Serve(listener) }()
+
+	url := fmt.Sprintf("http://%s/", listener.Addr())
+	Info("🌐 Web review session at " + url)
+	Muted("  Decide each finding in the browser, then choose how to proceed.")
+	openBrowser(url)
+
+	action := <-done
+	_ = server.Shutdown(context.Background())
+
+	s.clearState()
+	return SessionOutcome{
+		Action:        action,
+		Fixed:         s.fixed,
+		Skipped:       s.skipped,
+		ModifiedFiles: s.modifiedFiles(),
+	}
+}
+
+// webIndex extracts and validates the finding index of a form post,
+// rejecting indices already decided
+func (s *ReviewSession) webIndex(r *http.Request) (int, bool) {
+	idx, err := strconv.Atoi(r.FormValue("i"))
+	if err != nil || idx < 0 || idx >= len(s.suggestions) {
+		return 0, false
+	}
+	if s.fixedMap[idx] || s.skippedMap[idx] || s.decided[idx] {
+		return 0, false
+	}
+	return idx, true
+}
+
+// webData snapshots the session for one page render
+func (s *ReviewSession) webData(lastError string) webData {
+	data := webData{
+		Total:     len(s.suggestions),
+		Fixed:     s.fixed,
+		Skipped:   s.skipped,
+		LastError: lastError,
+	}
+	for i, sug := range s.suggestions {
+		fix := sug.SuggestFix
+		if fix == "N/A" {
+			fix = ""
+		}
+		data.Findings = append(data.Findings, webFinding{
+			Index:       i,
+			File:        sug.File,
+			Line:        sug.Line,
+			Severity:    string(sug.Severity),
+			Confidence:  string(sug.Confidence),
+			Category:    sug.Category,
+			Title:       sug.Title,
+			Description: sug.Description,
+			Fix:         fix,
+			Fixable:     fix != "" && sug.OriginalCode != "" && sug.OriginalCode != "N/A" && !sug.Unfixable,
+			Fixed:       s.fixedMap[i],
+			Skipped:     s.skippedMap[i] || s.decided[i] && !s.fixedMap[i],
+		})
+	}
+	data.Undecided = data.Total - s.fixed - s.skipped
+	return data
+}
+
+// openBrowser opens the URL with the platform's default browser, best
+// effort - the URL is printed either way
+func openBrowser(url string) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	_ = cmd.Start()
+}